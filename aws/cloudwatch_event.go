@@ -0,0 +1,122 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+)
+
+const cloudWatchEventsDefaultEventBusName = "default"
+
+// cloudWatchEventsIDSeparator separates the event bus name from the rule, target
+// or permission statement identifiers. Those identifiers cannot contain the
+// separator, while partner event bus names (e.g. aws.partner/example.com/123/name) can.
+const cloudWatchEventsIDSeparator = "/"
+
+// cloudWatchEventRuleID returns the resource ID of a rule. Rules on the default
+// event bus keep the plain rule name as ID for backwards compatibility.
+func cloudWatchEventRuleID(eventBusName, ruleName string) string {
+	if eventBusName == "" || eventBusName == cloudWatchEventsDefaultEventBusName {
+		return ruleName
+	}
+
+	return eventBusName + cloudWatchEventsIDSeparator + ruleName
+}
+
+func decodeCloudWatchEventRuleID(id string) (string, string, error) {
+	if id == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected RULE-NAME or EVENT-BUS-NAME/RULE-NAME", id)
+	}
+
+	i := strings.LastIndex(id, cloudWatchEventsIDSeparator)
+
+	if i == -1 {
+		return cloudWatchEventsDefaultEventBusName, id, nil
+	}
+
+	eventBusName, ruleName := id[:i], id[i+1:]
+
+	if eventBusName == "" || ruleName == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected RULE-NAME or EVENT-BUS-NAME/RULE-NAME", id)
+	}
+
+	return eventBusName, ruleName, nil
+}
+
+// cloudWatchEventTargetID returns the resource ID of a target. Targets of rules on the
+// default event bus keep the RULE-NAME-TARGET-ID format for backwards compatibility.
+func cloudWatchEventTargetID(eventBusName, ruleName, targetID string) string {
+	id := ruleName + "-" + targetID
+
+	if eventBusName == "" || eventBusName == cloudWatchEventsDefaultEventBusName {
+		return id
+	}
+
+	return eventBusName + cloudWatchEventsIDSeparator + id
+}
+
+// decodeCloudWatchEventTargetEventBusName returns the event bus name encoded in a target
+// resource ID. IDs without an event bus name, including those created before event bus
+// support, belong to the default event bus.
+func decodeCloudWatchEventTargetEventBusName(id string) string {
+	i := strings.LastIndex(id, cloudWatchEventsIDSeparator)
+
+	if i <= 0 {
+		return cloudWatchEventsDefaultEventBusName
+	}
+
+	return id[:i]
+}
+
+// decodeCloudWatchEventTargetImportID parses an import ID in the
+// RULE-NAME/TARGET-ID or EVENT-BUS-NAME/RULE-NAME/TARGET-ID format.
+func decodeCloudWatchEventTargetImportID(id string) (string, string, string, error) {
+	parts := strings.Split(id, cloudWatchEventsIDSeparator)
+
+	if len(parts) < 2 {
+		return "", "", "", fmt.Errorf("Unexpected format of ID (%q), expected RULE-NAME/TARGET-ID or EVENT-BUS-NAME/RULE-NAME/TARGET-ID", id)
+	}
+
+	targetID := parts[len(parts)-1]
+	ruleName := parts[len(parts)-2]
+	eventBusName := cloudWatchEventsDefaultEventBusName
+
+	if len(parts) > 2 {
+		eventBusName = strings.Join(parts[:len(parts)-2], cloudWatchEventsIDSeparator)
+	}
+
+	if eventBusName == "" || ruleName == "" || targetID == "" {
+		return "", "", "", fmt.Errorf("Unexpected format of ID (%q), expected RULE-NAME/TARGET-ID or EVENT-BUS-NAME/RULE-NAME/TARGET-ID", id)
+	}
+
+	return eventBusName, ruleName, targetID, nil
+}
+
+// cloudWatchEventPermissionID returns the resource ID of a permission. Permissions on the
+// default event bus keep the plain statement ID as ID for backwards compatibility.
+func cloudWatchEventPermissionID(eventBusName, statementID string) string {
+	if eventBusName == "" || eventBusName == cloudWatchEventsDefaultEventBusName {
+		return statementID
+	}
+
+	return eventBusName + cloudWatchEventsIDSeparator + statementID
+}
+
+func decodeCloudWatchEventPermissionID(id string) (string, string, error) {
+	if id == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected STATEMENT-ID or EVENT-BUS-NAME/STATEMENT-ID", id)
+	}
+
+	i := strings.LastIndex(id, cloudWatchEventsIDSeparator)
+
+	if i == -1 {
+		return cloudWatchEventsDefaultEventBusName, id, nil
+	}
+
+	eventBusName, statementID := id[:i], id[i+1:]
+
+	if eventBusName == "" || statementID == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected STATEMENT-ID or EVENT-BUS-NAME/STATEMENT-ID", id)
+	}
+
+	return eventBusName, statementID, nil
+}
@@ -0,0 +1,208 @@
+package aws
+
+import (
+	"testing"
+)
+
+func TestDecodeCloudWatchEventRuleID(t *testing.T) {
+	testCases := []struct {
+		ID           string
+		EventBusName string
+		RuleName     string
+		ErrCount     int
+	}{
+		{
+			ID:       "",
+			ErrCount: 1,
+		},
+		{
+			ID:       "/",
+			ErrCount: 1,
+		},
+		{
+			ID:       "test-bus/",
+			ErrCount: 1,
+		},
+		{
+			ID:           "test-rule",
+			EventBusName: "default",
+			RuleName:     "test-rule",
+		},
+		{
+			ID:           "test-bus/test-rule",
+			EventBusName: "test-bus",
+			RuleName:     "test-rule",
+		},
+		{
+			ID:           "aws.partner/example.com/123456789012/test-bus/test-rule",
+			EventBusName: "aws.partner/example.com/123456789012/test-bus",
+			RuleName:     "test-rule",
+		},
+	}
+
+	for _, tc := range testCases {
+		eventBusName, ruleName, err := decodeCloudWatchEventRuleID(tc.ID)
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("expected %q not to trigger an error, received: %s", tc.ID, err)
+		}
+		if tc.ErrCount > 0 && err == nil {
+			t.Fatalf("expected %q to trigger an error", tc.ID)
+		}
+		if eventBusName != tc.EventBusName {
+			t.Fatalf("expected %q to return event bus name (%s), received: %s", tc.ID, tc.EventBusName, eventBusName)
+		}
+		if ruleName != tc.RuleName {
+			t.Fatalf("expected %q to return rule name (%s), received: %s", tc.ID, tc.RuleName, ruleName)
+		}
+		if err == nil {
+			if id := cloudWatchEventRuleID(eventBusName, ruleName); id != tc.ID {
+				t.Fatalf("expected %q to round trip, received: %s", tc.ID, id)
+			}
+		}
+	}
+}
+
+func TestDecodeCloudWatchEventTargetEventBusName(t *testing.T) {
+	testCases := []struct {
+		ID           string
+		EventBusName string
+	}{
+		{
+			ID:           "test-rule-test-target",
+			EventBusName: "default",
+		},
+		{
+			ID:           "test-bus/test-rule-test-target",
+			EventBusName: "test-bus",
+		},
+		{
+			ID:           "aws.partner/example.com/123456789012/test-bus/test-rule-test-target",
+			EventBusName: "aws.partner/example.com/123456789012/test-bus",
+		},
+	}
+
+	for _, tc := range testCases {
+		eventBusName := decodeCloudWatchEventTargetEventBusName(tc.ID)
+		if eventBusName != tc.EventBusName {
+			t.Fatalf("expected %q to return event bus name (%s), received: %s", tc.ID, tc.EventBusName, eventBusName)
+		}
+	}
+}
+
+func TestDecodeCloudWatchEventTargetImportID(t *testing.T) {
+	testCases := []struct {
+		ID           string
+		EventBusName string
+		RuleName     string
+		TargetID     string
+		ErrCount     int
+	}{
+		{
+			ID:       "",
+			ErrCount: 1,
+		},
+		{
+			ID:       "test-rule",
+			ErrCount: 1,
+		},
+		{
+			ID:       "test-rule/",
+			ErrCount: 1,
+		},
+		{
+			ID:       "/test-rule/test-target",
+			ErrCount: 1,
+		},
+		{
+			ID:           "test-rule/test-target",
+			EventBusName: "default",
+			RuleName:     "test-rule",
+			TargetID:     "test-target",
+		},
+		{
+			ID:           "test-bus/test-rule/test-target",
+			EventBusName: "test-bus",
+			RuleName:     "test-rule",
+			TargetID:     "test-target",
+		},
+		{
+			ID:           "aws.partner/example.com/123456789012/test-bus/test-rule/test-target",
+			EventBusName: "aws.partner/example.com/123456789012/test-bus",
+			RuleName:     "test-rule",
+			TargetID:     "test-target",
+		},
+	}
+
+	for _, tc := range testCases {
+		eventBusName, ruleName, targetID, err := decodeCloudWatchEventTargetImportID(tc.ID)
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("expected %q not to trigger an error, received: %s", tc.ID, err)
+		}
+		if tc.ErrCount > 0 && err == nil {
+			t.Fatalf("expected %q to trigger an error", tc.ID)
+		}
+		if eventBusName != tc.EventBusName {
+			t.Fatalf("expected %q to return event bus name (%s), received: %s", tc.ID, tc.EventBusName, eventBusName)
+		}
+		if ruleName != tc.RuleName {
+			t.Fatalf("expected %q to return rule name (%s), received: %s", tc.ID, tc.RuleName, ruleName)
+		}
+		if targetID != tc.TargetID {
+			t.Fatalf("expected %q to return target ID (%s), received: %s", tc.ID, tc.TargetID, targetID)
+		}
+	}
+}
+
+func TestDecodeCloudWatchEventPermissionID(t *testing.T) {
+	testCases := []struct {
+		ID           string
+		EventBusName string
+		StatementID  string
+		ErrCount     int
+	}{
+		{
+			ID:       "",
+			ErrCount: 1,
+		},
+		{
+			ID:       "/test-statement",
+			ErrCount: 1,
+		},
+		{
+			ID:           "test-statement",
+			EventBusName: "default",
+			StatementID:  "test-statement",
+		},
+		{
+			ID:           "test-bus/test-statement",
+			EventBusName: "test-bus",
+			StatementID:  "test-statement",
+		},
+		{
+			ID:           "aws.partner/example.com/123456789012/test-bus/test-statement",
+			EventBusName: "aws.partner/example.com/123456789012/test-bus",
+			StatementID:  "test-statement",
+		},
+	}
+
+	for _, tc := range testCases {
+		eventBusName, statementID, err := decodeCloudWatchEventPermissionID(tc.ID)
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("expected %q not to trigger an error, received: %s", tc.ID, err)
+		}
+		if tc.ErrCount > 0 && err == nil {
+			t.Fatalf("expected %q to trigger an error", tc.ID)
+		}
+		if eventBusName != tc.EventBusName {
+			t.Fatalf("expected %q to return event bus name (%s), received: %s", tc.ID, tc.EventBusName, eventBusName)
+		}
+		if statementID != tc.StatementID {
+			t.Fatalf("expected %q to return statement ID (%s), received: %s", tc.ID, tc.StatementID, statementID)
+		}
+		if err == nil {
+			if id := cloudWatchEventPermissionID(eventBusName, statementID); id != tc.ID {
+				t.Fatalf("expected %q to round trip, received: %s", tc.ID, id)
+			}
+		}
+	}
+}
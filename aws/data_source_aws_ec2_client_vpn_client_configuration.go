@@ -0,0 +1,57 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceAwsEc2ClientVpnClientConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsEc2ClientVpnClientConfigurationRead,
+
+		Schema: map[string]*schema.Schema{
+			"client_configuration": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"client_vpn_endpoint_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func dataSourceAwsEc2ClientVpnClientConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	clientVpnEndpointID := d.Get("client_vpn_endpoint_id").(string)
+
+	input := &ec2.ExportClientVpnClientConfigurationInput{
+		ClientVpnEndpointId: aws.String(clientVpnEndpointID),
+	}
+
+	log.Printf("[DEBUG] Exporting EC2 Client VPN Endpoint (%s) client configuration: %s", clientVpnEndpointID, input)
+	output, err := conn.ExportClientVpnClientConfiguration(input)
+
+	if err != nil {
+		return fmt.Errorf("error exporting EC2 Client VPN Endpoint (%s) client configuration: %s", clientVpnEndpointID, err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("error exporting EC2 Client VPN Endpoint (%s) client configuration: empty response", clientVpnEndpointID)
+	}
+
+	d.SetId(clientVpnEndpointID)
+	d.Set("client_configuration", output.ClientConfiguration)
+	d.Set("client_vpn_endpoint_id", clientVpnEndpointID)
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package aws
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAwsEc2ClientVpnClientConfiguration_basic(t *testing.T) {
+	dataSourceName := "data.aws_ec2_client_vpn_client_configuration.test"
+	endpointResourceName := "aws_ec2_client_vpn_endpoint.test"
+	rStr := acctest.RandString(5)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProvidersWithTLS,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsEc2ClientVpnClientConfigurationConfig(rStr),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "client_vpn_endpoint_id", endpointResourceName, "id"),
+					resource.TestMatchResourceAttr(dataSourceName, "client_configuration", regexp.MustCompile(`remote cvpn-endpoint-`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsEc2ClientVpnClientConfigurationConfig(rName string) string {
+	return testAccEc2ClientVpnNetworkAssociationConfig(rName) + `
+data "aws_ec2_client_vpn_client_configuration" "test" {
+  client_vpn_endpoint_id = "${aws_ec2_client_vpn_network_association.test.client_vpn_endpoint_id}"
+}
+`
+}
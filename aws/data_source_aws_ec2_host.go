@@ -0,0 +1,150 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsEc2Host() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsEc2HostRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auto_placement": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cores": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"filter": ec2CustomFiltersSchema(),
+			"host_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"host_recovery": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"instance_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sockets": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchemaComputed(),
+			"total_vcpus": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsEc2HostRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	input := &ec2.DescribeHostsInput{}
+
+	if v, ok := d.GetOk("host_id"); ok {
+		input.HostIds = aws.StringSlice([]string{v.(string)})
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		input.Filter = append(input.Filter, buildEC2TagFilterList(
+			tagsFromMap(v.(map[string]interface{})),
+		)...)
+	}
+
+	input.Filter = append(input.Filter, buildEC2CustomFilterList(
+		d.Get("filter").(*schema.Set),
+	)...)
+
+	if len(input.Filter) == 0 {
+		// Don't send an empty filters list; the EC2 API won't accept it.
+		input.Filter = nil
+	}
+
+	log.Printf("[DEBUG] Reading EC2 Hosts: %s", input)
+	var hosts []*ec2.Host
+	for {
+		output, err := conn.DescribeHosts(input)
+
+		if err != nil {
+			return fmt.Errorf("error reading EC2 Hosts: %s", err)
+		}
+
+		if output == nil {
+			break
+		}
+
+		hosts = append(hosts, output.Hosts...)
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	if len(hosts) == 0 || hosts[0] == nil {
+		return fmt.Errorf("no matching EC2 Host found")
+	}
+
+	if len(hosts) > 1 {
+		return fmt.Errorf("multiple EC2 Hosts matched; use additional constraints to reduce matches to a single EC2 Host")
+	}
+
+	host := hosts[0]
+
+	d.SetId(aws.StringValue(host.HostId))
+
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "ec2",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("dedicated-host/%s", d.Id()),
+	}.String()
+
+	d.Set("arn", arn)
+	d.Set("auto_placement", host.AutoPlacement)
+	d.Set("availability_zone", host.AvailabilityZone)
+	d.Set("host_id", host.HostId)
+	d.Set("host_recovery", host.HostRecovery)
+	d.Set("state", host.State)
+
+	if v := host.HostProperties; v != nil {
+		d.Set("cores", aws.Int64Value(v.Cores))
+		d.Set("instance_type", v.InstanceType)
+		d.Set("sockets", aws.Int64Value(v.Sockets))
+		d.Set("total_vcpus", aws.Int64Value(v.TotalVCpus))
+	}
+
+	if err := d.Set("tags", tagsToMap(host.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
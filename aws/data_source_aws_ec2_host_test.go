@@ -0,0 +1,102 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSEc2HostDataSource_HostID(t *testing.T) {
+	dataSourceName := "data.aws_ec2_host.test"
+	resourceName := "aws_ec2_host.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2HostDataSourceConfigHostID(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "auto_placement", resourceName, "auto_placement"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "availability_zone", resourceName, "availability_zone"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "cores"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "host_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "host_recovery", resourceName, "host_recovery"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "instance_type", resourceName, "instance_type"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "sockets"),
+					resource.TestCheckResourceAttr(dataSourceName, "state", "available"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "total_vcpus"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2HostDataSource_Filter(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_ec2_host.test"
+	resourceName := "aws_ec2_host.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2HostDataSourceConfigFilter(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "host_id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "instance_type", resourceName, "instance_type"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.Name", rName),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSEc2HostDataSourceConfigHostID() string {
+	return `
+data "aws_availability_zones" "available" {}
+
+resource "aws_ec2_host" "test" {
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+  instance_type     = "a1.large"
+}
+
+data "aws_ec2_host" "test" {
+  host_id = "${aws_ec2_host.test.id}"
+}
+`
+}
+
+func testAccAWSEc2HostDataSourceConfigFilter(rName string) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {}
+
+resource "aws_ec2_host" "test" {
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+  instance_type     = "a1.large"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_ec2_host" "test" {
+  filter {
+    name   = "availability-zone"
+    values = ["${aws_ec2_host.test.availability_zone}"]
+  }
+
+  filter {
+    name   = "tag:Name"
+    values = ["${aws_ec2_host.test.tags["Name"]}"]
+  }
+}
+`, rName)
+}
@@ -0,0 +1,214 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsIAMServiceLastAccessedDetails() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsIAMServiceLastAccessedDetailsRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"job_completion_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"job_creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"job_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"services_last_accessed": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"last_authenticated": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_authenticated_entity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service_namespace": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"total_authenticated_entities": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsIAMServiceLastAccessedDetailsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	arn := d.Get("arn").(string)
+
+	input := &iam.GenerateServiceLastAccessedDetailsInput{
+		Arn: aws.String(arn),
+	}
+
+	log.Printf("[DEBUG] Generating IAM Service Last Accessed Details: %s", input)
+	output, err := conn.GenerateServiceLastAccessedDetails(input)
+
+	if err != nil {
+		return fmt.Errorf("error generating IAM Service Last Accessed Details (%s): %s", arn, err)
+	}
+
+	if output == nil || output.JobId == nil {
+		return fmt.Errorf("error generating IAM Service Last Accessed Details (%s): empty response", arn)
+	}
+
+	jobID := aws.StringValue(output.JobId)
+
+	job, err := waitForIamServiceLastAccessedDetailsJobCompletion(conn, jobID)
+
+	if err != nil {
+		return fmt.Errorf("error waiting for IAM Service Last Accessed Details (%s) job (%s) completion: %s", arn, jobID, err)
+	}
+
+	servicesLastAccessed, err := iamListServicesLastAccessed(conn, jobID)
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM Service Last Accessed Details (%s) job (%s): %s", arn, jobID, err)
+	}
+
+	d.SetId(jobID)
+	d.Set("job_completion_date", aws.TimeValue(job.JobCompletionDate).Format(time.RFC3339))
+	d.Set("job_creation_date", aws.TimeValue(job.JobCreationDate).Format(time.RFC3339))
+	d.Set("job_id", jobID)
+
+	if err := d.Set("services_last_accessed", flattenIamServicesLastAccessed(servicesLastAccessed)); err != nil {
+		return fmt.Errorf("error setting services_last_accessed: %s", err)
+	}
+
+	return nil
+}
+
+func iamServiceLastAccessedDetailsJobRefreshFunc(conn *iam.IAM, jobID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.GetServiceLastAccessedDetails(&iam.GetServiceLastAccessedDetailsInput{
+			JobId: aws.String(jobID),
+		})
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil {
+			return nil, "", fmt.Errorf("empty response")
+		}
+
+		status := aws.StringValue(output.JobStatus)
+
+		if status == iam.JobStatusTypeFailed {
+			if output.Error != nil {
+				return output, status, fmt.Errorf("%s: %s", aws.StringValue(output.Error.Code), aws.StringValue(output.Error.Message))
+			}
+
+			return output, status, fmt.Errorf("job failed")
+		}
+
+		return output, status, nil
+	}
+}
+
+func waitForIamServiceLastAccessedDetailsJobCompletion(conn *iam.IAM, jobID string) (*iam.GetServiceLastAccessedDetailsOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{iam.JobStatusTypeInProgress},
+		Target:     []string{iam.JobStatusTypeCompleted},
+		Refresh:    iamServiceLastAccessedDetailsJobRefreshFunc(conn, jobID),
+		Timeout:    10 * time.Minute,
+		MinTimeout: 2 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for IAM Service Last Accessed Details job (%s) completion", jobID)
+	output, err := stateConf.WaitForState()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output.(*iam.GetServiceLastAccessedDetailsOutput), nil
+}
+
+func iamListServicesLastAccessed(conn *iam.IAM, jobID string) ([]*iam.ServiceLastAccessed, error) {
+	var servicesLastAccessed []*iam.ServiceLastAccessed
+
+	input := &iam.GetServiceLastAccessedDetailsInput{
+		JobId: aws.String(jobID),
+	}
+
+	for {
+		output, err := conn.GetServiceLastAccessedDetails(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil {
+			break
+		}
+
+		servicesLastAccessed = append(servicesLastAccessed, output.ServicesLastAccessed...)
+
+		if !aws.BoolValue(output.IsTruncated) {
+			break
+		}
+
+		input.Marker = output.Marker
+	}
+
+	return servicesLastAccessed, nil
+}
+
+func flattenIamServicesLastAccessed(servicesLastAccessed []*iam.ServiceLastAccessed) []interface{} {
+	l := make([]interface{}, 0, len(servicesLastAccessed))
+
+	for _, serviceLastAccessed := range servicesLastAccessed {
+		if serviceLastAccessed == nil {
+			continue
+		}
+
+		var lastAuthenticated string
+		if v := serviceLastAccessed.LastAuthenticated; v != nil {
+			lastAuthenticated = aws.TimeValue(v).Format(time.RFC3339)
+		}
+
+		l = append(l, map[string]interface{}{
+			"last_authenticated":           lastAuthenticated,
+			"last_authenticated_entity":    aws.StringValue(serviceLastAccessed.LastAuthenticatedEntity),
+			"service_name":                 aws.StringValue(serviceLastAccessed.ServiceName),
+			"service_namespace":            aws.StringValue(serviceLastAccessed.ServiceNamespace),
+			"total_authenticated_entities": int(aws.Int64Value(serviceLastAccessed.TotalAuthenticatedEntities)),
+		})
+	}
+
+	return l
+}
@@ -0,0 +1,84 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsKinesisStreamConsumer() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsKinesisStreamConsumerRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"creation_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"stream_arn": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsKinesisStreamConsumerRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisconn
+
+	input := &kinesis.DescribeStreamConsumerInput{
+		StreamARN: aws.String(d.Get("stream_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("arn"); ok {
+		input.ConsumerARN = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		input.ConsumerName = aws.String(v.(string))
+	}
+
+	if input.ConsumerARN == nil && input.ConsumerName == nil {
+		return fmt.Errorf("one of arn or name must be specified")
+	}
+
+	log.Printf("[DEBUG] Reading Kinesis Stream Consumer: %s", input)
+	output, err := conn.DescribeStreamConsumer(input)
+
+	if err != nil {
+		return fmt.Errorf("error reading Kinesis Stream Consumer: %s", err)
+	}
+
+	if output == nil || output.ConsumerDescription == nil {
+		return fmt.Errorf("error reading Kinesis Stream Consumer: empty response")
+	}
+
+	consumer := output.ConsumerDescription
+
+	d.SetId(aws.StringValue(consumer.ConsumerARN))
+	d.Set("arn", consumer.ConsumerARN)
+	d.Set("creation_timestamp", aws.TimeValue(consumer.ConsumerCreationTimestamp).Format(time.RFC3339))
+	d.Set("name", consumer.ConsumerName)
+	d.Set("status", consumer.ConsumerStatus)
+	d.Set("stream_arn", consumer.StreamARN)
+
+	return nil
+}
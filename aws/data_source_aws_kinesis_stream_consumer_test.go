@@ -0,0 +1,70 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSKinesisStreamConsumerDataSource_Name(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_kinesis_stream_consumer.test"
+	resourceName := "aws_kinesis_stream_consumer.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKinesisStreamConsumerDataSourceConfigName(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "creation_timestamp", resourceName, "creation_timestamp"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "stream_arn", resourceName, "stream_arn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSKinesisStreamConsumerDataSource_Arn(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_kinesis_stream_consumer.test"
+	resourceName := "aws_kinesis_stream_consumer.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKinesisStreamConsumerDataSourceConfigArn(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "status", "ACTIVE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSKinesisStreamConsumerDataSourceConfigName(rName string) string {
+	return testAccAWSKinesisStreamConsumerConfig(rName) + `
+data "aws_kinesis_stream_consumer" "test" {
+  name       = "${aws_kinesis_stream_consumer.test.name}"
+  stream_arn = "${aws_kinesis_stream_consumer.test.stream_arn}"
+}
+`
+}
+
+func testAccAWSKinesisStreamConsumerDataSourceConfigArn(rName string) string {
+	return testAccAWSKinesisStreamConsumerConfig(rName) + `
+data "aws_kinesis_stream_consumer" "test" {
+  arn        = "${aws_kinesis_stream_consumer.test.arn}"
+  stream_arn = "${aws_kinesis_stream_consumer.test.stream_arn}"
+}
+`
+}
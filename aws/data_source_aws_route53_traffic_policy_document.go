@@ -0,0 +1,422 @@
+package aws
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceAwsRoute53TrafficPolicyDocument() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsRoute53TrafficPolicyDocumentRead,
+
+		Schema: map[string]*schema.Schema{
+			"endpoint": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"application-load-balancer",
+								"cloudfront",
+								"elastic-beanstalk",
+								"elastic-load-balancer",
+								"network-load-balancer",
+								"s3-website",
+								"value",
+							}, false),
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"record_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"rule": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"geo_proximity_location": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bias": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"endpoint_reference": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"evaluate_target_health": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+									"health_check": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"latitude": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"longitude": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"region": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"rule_reference": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"items": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"endpoint_reference": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"health_check": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"location": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"continent": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"country": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"endpoint_reference": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"evaluate_target_health": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+									"health_check": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"is_default": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"rule_reference": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"subdivision": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"primary":   dataSourceAwsRoute53TrafficPolicyFailoverRuleSchema(),
+						"secondary": dataSourceAwsRoute53TrafficPolicyFailoverRuleSchema(),
+						"region": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"endpoint_reference": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"evaluate_target_health": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+									"health_check": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"region": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"rule_reference": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"failover",
+								"geo",
+								"geoproximity",
+								"latency",
+								"multivalue",
+							}, false),
+						},
+					},
+				},
+			},
+			"start_endpoint": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"start_rule": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "2015-10-01",
+				ValidateFunc: validation.StringInSlice([]string{"2015-10-01"}, false),
+			},
+		},
+	}
+}
+
+func dataSourceAwsRoute53TrafficPolicyFailoverRuleSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"endpoint_reference": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"evaluate_target_health": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  true,
+				},
+				"health_check": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"rule_reference": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsRoute53TrafficPolicyDocumentRead(d *schema.ResourceData, meta interface{}) error {
+	doc := &Route53TrafficPolicyDoc{
+		AWSPolicyFormatVersion: d.Get("version").(string),
+		RecordType:             d.Get("record_type").(string),
+		StartEndpoint:          d.Get("start_endpoint").(string),
+		StartRule:              d.Get("start_rule").(string),
+	}
+
+	if v, ok := d.GetOk("endpoint"); ok && v.(*schema.Set).Len() > 0 {
+		doc.Endpoints = expandRoute53TrafficPolicyDocEndpoints(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("rule"); ok && v.(*schema.Set).Len() > 0 {
+		doc.Rules = expandRoute53TrafficPolicyDocRules(v.(*schema.Set).List())
+	}
+
+	jsonDoc, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		// should never happen if the above code is correct
+		return err
+	}
+	jsonString := string(jsonDoc)
+
+	d.Set("json", jsonString)
+	d.SetId(strconv.Itoa(hashcode.String(jsonString)))
+
+	return nil
+}
+
+func expandRoute53TrafficPolicyDocEndpoints(l []interface{}) map[string]*Route53TrafficPolicyEndpoint {
+	endpoints := make(map[string]*Route53TrafficPolicyEndpoint, len(l))
+
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		endpoints[tfMap["id"].(string)] = &Route53TrafficPolicyEndpoint{
+			Region: tfMap["region"].(string),
+			Type:   tfMap["type"].(string),
+			Value:  tfMap["value"].(string),
+		}
+	}
+
+	return endpoints
+}
+
+func expandRoute53TrafficPolicyDocRules(l []interface{}) map[string]*Route53TrafficPolicyRule {
+	rules := make(map[string]*Route53TrafficPolicyRule, len(l))
+
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		rule := &Route53TrafficPolicyRule{
+			RuleType:  tfMap["type"].(string),
+			Primary:   expandRoute53TrafficPolicyDocFailoverRule(tfMap["primary"].([]interface{})),
+			Secondary: expandRoute53TrafficPolicyDocFailoverRule(tfMap["secondary"].([]interface{})),
+		}
+
+		for _, vRaw := range tfMap["location"].([]interface{}) {
+			v, ok := vRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			rule.Locations = append(rule.Locations, &Route53TrafficPolicyGeolocationRule{
+				Continent:            v["continent"].(string),
+				Country:              v["country"].(string),
+				EndpointReference:    v["endpoint_reference"].(string),
+				EvaluateTargetHealth: expandRoute53TrafficPolicyDocEvaluateTargetHealth(v["evaluate_target_health"]),
+				HealthCheck:          v["health_check"].(string),
+				IsDefault:            expandRoute53TrafficPolicyDocBool(v["is_default"]),
+				RuleReference:        v["rule_reference"].(string),
+				Subdivision:          v["subdivision"].(string),
+			})
+		}
+
+		for _, vRaw := range tfMap["geo_proximity_location"].([]interface{}) {
+			v, ok := vRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			rule.GeoProximityLocations = append(rule.GeoProximityLocations, &Route53TrafficPolicyGeoproximityRule{
+				Bias:                 v["bias"].(string),
+				EndpointReference:    v["endpoint_reference"].(string),
+				EvaluateTargetHealth: expandRoute53TrafficPolicyDocEvaluateTargetHealth(v["evaluate_target_health"]),
+				HealthCheck:          v["health_check"].(string),
+				Latitude:             v["latitude"].(string),
+				Longitude:            v["longitude"].(string),
+				Region:               v["region"].(string),
+				RuleReference:        v["rule_reference"].(string),
+			})
+		}
+
+		for _, vRaw := range tfMap["region"].([]interface{}) {
+			v, ok := vRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			rule.Regions = append(rule.Regions, &Route53TrafficPolicyLatencyRule{
+				EndpointReference:    v["endpoint_reference"].(string),
+				EvaluateTargetHealth: expandRoute53TrafficPolicyDocEvaluateTargetHealth(v["evaluate_target_health"]),
+				HealthCheck:          v["health_check"].(string),
+				Region:               v["region"].(string),
+				RuleReference:        v["rule_reference"].(string),
+			})
+		}
+
+		for _, vRaw := range tfMap["items"].([]interface{}) {
+			v, ok := vRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			rule.Items = append(rule.Items, &Route53TrafficPolicyMultiValueAnswerRule{
+				EndpointReference: v["endpoint_reference"].(string),
+				HealthCheck:       v["health_check"].(string),
+			})
+		}
+
+		rules[tfMap["id"].(string)] = rule
+	}
+
+	return rules
+}
+
+func expandRoute53TrafficPolicyDocFailoverRule(l []interface{}) *Route53TrafficPolicyFailoverRule {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	tfMap := l[0].(map[string]interface{})
+
+	return &Route53TrafficPolicyFailoverRule{
+		EndpointReference:    tfMap["endpoint_reference"].(string),
+		EvaluateTargetHealth: expandRoute53TrafficPolicyDocEvaluateTargetHealth(tfMap["evaluate_target_health"]),
+		HealthCheck:          tfMap["health_check"].(string),
+		RuleReference:        tfMap["rule_reference"].(string),
+	}
+}
+
+// expandRoute53TrafficPolicyDocEvaluateTargetHealth only emits false values, as
+// Route 53 evaluates target health unless it is explicitly disabled.
+func expandRoute53TrafficPolicyDocEvaluateTargetHealth(v interface{}) *bool {
+	if b, ok := v.(bool); ok && !b {
+		return &b
+	}
+
+	return nil
+}
+
+// expandRoute53TrafficPolicyDocBool omits false values so that the
+// generated document only contains flags that have been explicitly enabled.
+func expandRoute53TrafficPolicyDocBool(v interface{}) *bool {
+	if b, ok := v.(bool); ok && b {
+		return &b
+	}
+
+	return nil
+}
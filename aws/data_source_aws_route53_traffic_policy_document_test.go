@@ -0,0 +1,179 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSDataSourceRoute53TrafficPolicyDocument_basic(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRoute53TrafficPolicyDocumentConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRoute53TrafficPolicyDocumentEquivalent("data.aws_route53_traffic_policy_document.test", testAccAWSRoute53TrafficPolicyDocumentExpectedJSON),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDataSourceRoute53TrafficPolicyDocument_EvaluateTargetHealth(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRoute53TrafficPolicyDocumentConfigEvaluateTargetHealth,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRoute53TrafficPolicyDocumentEquivalent("data.aws_route53_traffic_policy_document.test", testAccAWSRoute53TrafficPolicyDocumentEvaluateTargetHealthExpectedJSON),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSRoute53TrafficPolicyDocumentEquivalent(resourceName, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		actual := rs.Primary.Attributes["json"]
+
+		if !suppressEquivalentJsonDiffs("", actual, expected, nil) {
+			return fmt.Errorf("documents are not equivalent.\nexpected:\n%s\n\nactual:\n%s", expected, actual)
+		}
+
+		return nil
+	}
+}
+
+const testAccAWSRoute53TrafficPolicyDocumentConfig = `
+data "aws_route53_traffic_policy_document" "test" {
+  record_type = "A"
+  start_rule  = "site_switch"
+
+  endpoint {
+    id    = "my_elb"
+    type  = "elastic-load-balancer"
+    value = "elb-111111.us-east-1.elb.amazonaws.com"
+  }
+
+  endpoint {
+    id     = "site_down_banner"
+    type   = "s3-website"
+    region = "us-east-1"
+    value  = "www.example.com"
+  }
+
+  rule {
+    id   = "site_switch"
+    type = "failover"
+
+    primary {
+      endpoint_reference = "my_elb"
+    }
+
+    secondary {
+      endpoint_reference = "site_down_banner"
+    }
+  }
+}
+`
+
+const testAccAWSRoute53TrafficPolicyDocumentExpectedJSON = `{
+  "AWSPolicyFormatVersion": "2015-10-01",
+  "RecordType": "A",
+  "StartRule": "site_switch",
+  "Endpoints": {
+    "my_elb": {
+      "Type": "elastic-load-balancer",
+      "Value": "elb-111111.us-east-1.elb.amazonaws.com"
+    },
+    "site_down_banner": {
+      "Type": "s3-website",
+      "Region": "us-east-1",
+      "Value": "www.example.com"
+    }
+  },
+  "Rules": {
+    "site_switch": {
+      "RuleType": "failover",
+      "Primary": {
+        "EndpointReference": "my_elb"
+      },
+      "Secondary": {
+        "EndpointReference": "site_down_banner"
+      }
+    }
+  }
+}`
+
+const testAccAWSRoute53TrafficPolicyDocumentConfigEvaluateTargetHealth = `
+data "aws_route53_traffic_policy_document" "test" {
+  record_type = "A"
+  start_rule  = "site_switch"
+
+  endpoint {
+    id    = "my_elb"
+    type  = "elastic-load-balancer"
+    value = "elb-111111.us-east-1.elb.amazonaws.com"
+  }
+
+  endpoint {
+    id    = "my_other_elb"
+    type  = "elastic-load-balancer"
+    value = "elb-222222.us-east-1.elb.amazonaws.com"
+  }
+
+  rule {
+    id   = "site_switch"
+    type = "failover"
+
+    primary {
+      endpoint_reference     = "my_elb"
+      evaluate_target_health = true
+    }
+
+    secondary {
+      endpoint_reference     = "my_other_elb"
+      evaluate_target_health = false
+    }
+  }
+}
+`
+
+const testAccAWSRoute53TrafficPolicyDocumentEvaluateTargetHealthExpectedJSON = `{
+  "AWSPolicyFormatVersion": "2015-10-01",
+  "RecordType": "A",
+  "StartRule": "site_switch",
+  "Endpoints": {
+    "my_elb": {
+      "Type": "elastic-load-balancer",
+      "Value": "elb-111111.us-east-1.elb.amazonaws.com"
+    },
+    "my_other_elb": {
+      "Type": "elastic-load-balancer",
+      "Value": "elb-222222.us-east-1.elb.amazonaws.com"
+    }
+  },
+  "Rules": {
+    "site_switch": {
+      "RuleType": "failover",
+      "Primary": {
+        "EndpointReference": "my_elb"
+      },
+      "Secondary": {
+        "EndpointReference": "my_other_elb",
+        "EvaluateTargetHealth": false
+      }
+    }
+  }
+}`
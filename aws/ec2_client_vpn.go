@@ -0,0 +1,268 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// clientVpnAuthorizationRuleStatusCodeRevoked is a pseudo status used when an
+// authorization rule is no longer returned by the API.
+const clientVpnAuthorizationRuleStatusCodeRevoked = "revoked"
+
+// clientVpnRouteStatusCodeDeleted is a pseudo status used when a route is no
+// longer returned by the API.
+const clientVpnRouteStatusCodeDeleted = "deleted"
+
+func decodeEc2ClientVpnAuthorizationRuleID(id string) (string, string, string, error) {
+	parts := strings.Split(id, "_")
+
+	switch len(parts) {
+	case 2:
+		return parts[0], parts[1], "", nil
+	case 3:
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("Unexpected format of ID (%q), expected cvpn-endpoint-ID_TARGET-NETWORK-CIDR or cvpn-endpoint-ID_TARGET-NETWORK-CIDR_ACCESS-GROUP-ID", id)
+}
+
+func decodeEc2ClientVpnNetworkAssociationID(id string) (string, string, error) {
+	parts := strings.Split(id, "_")
+
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected cvpn-endpoint-ID_cvpn-assoc-ID", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func decodeEc2ClientVpnRouteID(id string) (string, string, string, error) {
+	parts := strings.Split(id, "_")
+
+	if len(parts) != 3 {
+		return "", "", "", fmt.Errorf("Unexpected format of ID (%q), expected cvpn-endpoint-ID_subnet-ID_DESTINATION-CIDR", id)
+	}
+
+	return parts[0], parts[1], parts[2], nil
+}
+
+func ec2DescribeClientVpnAuthorizationRule(conn *ec2.EC2, clientVpnEndpointID, targetNetworkCidr, accessGroupID string) (*ec2.AuthorizationRule, error) {
+	input := &ec2.DescribeClientVpnAuthorizationRulesInput{
+		ClientVpnEndpointId: aws.String(clientVpnEndpointID),
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("destination-cidr"),
+				Values: []*string{aws.String(targetNetworkCidr)},
+			},
+		},
+	}
+
+	if accessGroupID != "" {
+		input.Filters = append(input.Filters, &ec2.Filter{
+			Name:   aws.String("group-id"),
+			Values: []*string{aws.String(accessGroupID)},
+		})
+	}
+
+	log.Printf("[DEBUG] Reading EC2 Client VPN Endpoint (%s) Authorization Rules: %s", clientVpnEndpointID, input)
+	for {
+		output, err := conn.DescribeClientVpnAuthorizationRules(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil || len(output.AuthorizationRules) == 0 {
+			return nil, nil
+		}
+
+		for _, rule := range output.AuthorizationRules {
+			if rule == nil {
+				continue
+			}
+
+			if aws.StringValue(rule.DestinationCidr) != targetNetworkCidr {
+				continue
+			}
+
+			if accessGroupID == "" && aws.BoolValue(rule.AccessAll) {
+				return rule, nil
+			}
+
+			if accessGroupID != "" && aws.StringValue(rule.GroupId) == accessGroupID {
+				return rule, nil
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return nil, nil
+}
+
+func ec2DescribeClientVpnRoute(conn *ec2.EC2, clientVpnEndpointID, targetSubnetID, destinationCidr string) (*ec2.ClientVpnRoute, error) {
+	input := &ec2.DescribeClientVpnRoutesInput{
+		ClientVpnEndpointId: aws.String(clientVpnEndpointID),
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("destination-cidr"),
+				Values: []*string{aws.String(destinationCidr)},
+			},
+			{
+				Name:   aws.String("target-subnet"),
+				Values: []*string{aws.String(targetSubnetID)},
+			},
+		},
+	}
+
+	log.Printf("[DEBUG] Reading EC2 Client VPN Endpoint (%s) Routes: %s", clientVpnEndpointID, input)
+	for {
+		output, err := conn.DescribeClientVpnRoutes(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil || len(output.Routes) == 0 {
+			return nil, nil
+		}
+
+		for _, route := range output.Routes {
+			if route == nil {
+				continue
+			}
+
+			if aws.StringValue(route.DestinationCidr) == destinationCidr && aws.StringValue(route.TargetSubnet) == targetSubnetID {
+				return route, nil
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return nil, nil
+}
+
+func ec2ClientVpnAuthorizationRuleRefreshFunc(conn *ec2.EC2, clientVpnEndpointID, targetNetworkCidr, accessGroupID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		rule, err := ec2DescribeClientVpnAuthorizationRule(conn, clientVpnEndpointID, targetNetworkCidr, accessGroupID)
+
+		if isAWSErr(err, "InvalidClientVpnEndpointId.NotFound", "") {
+			return nil, clientVpnAuthorizationRuleStatusCodeRevoked, nil
+		}
+
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading EC2 Client VPN Endpoint (%s) Authorization Rule (%s): %s", clientVpnEndpointID, targetNetworkCidr, err)
+		}
+
+		if rule == nil || rule.Status == nil {
+			return nil, clientVpnAuthorizationRuleStatusCodeRevoked, nil
+		}
+
+		if aws.StringValue(rule.Status.Code) == ec2.ClientVpnAuthorizationRuleStatusCodeFailed {
+			return rule, ec2.ClientVpnAuthorizationRuleStatusCodeFailed, fmt.Errorf("%s", aws.StringValue(rule.Status.Message))
+		}
+
+		return rule, aws.StringValue(rule.Status.Code), nil
+	}
+}
+
+func ec2ClientVpnRouteRefreshFunc(conn *ec2.EC2, clientVpnEndpointID, targetSubnetID, destinationCidr string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		route, err := ec2DescribeClientVpnRoute(conn, clientVpnEndpointID, targetSubnetID, destinationCidr)
+
+		if isAWSErr(err, "InvalidClientVpnEndpointId.NotFound", "") {
+			return nil, clientVpnRouteStatusCodeDeleted, nil
+		}
+
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading EC2 Client VPN Endpoint (%s) Route (%s): %s", clientVpnEndpointID, destinationCidr, err)
+		}
+
+		if route == nil || route.Status == nil {
+			return nil, clientVpnRouteStatusCodeDeleted, nil
+		}
+
+		if aws.StringValue(route.Status.Code) == ec2.ClientVpnRouteStatusCodeFailed {
+			return route, ec2.ClientVpnRouteStatusCodeFailed, fmt.Errorf("%s", aws.StringValue(route.Status.Message))
+		}
+
+		return route, aws.StringValue(route.Status.Code), nil
+	}
+}
+
+func waitForEc2ClientVpnAuthorizationRuleAuthorization(conn *ec2.EC2, clientVpnEndpointID, targetNetworkCidr, accessGroupID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.ClientVpnAuthorizationRuleStatusCodeAuthorizing},
+		Target:  []string{ec2.ClientVpnAuthorizationRuleStatusCodeActive},
+		Refresh: ec2ClientVpnAuthorizationRuleRefreshFunc(conn, clientVpnEndpointID, targetNetworkCidr, accessGroupID),
+		Timeout: timeout,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 Client VPN Endpoint (%s) Authorization Rule (%s) authorization", clientVpnEndpointID, targetNetworkCidr)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForEc2ClientVpnAuthorizationRuleRevocation(conn *ec2.EC2, clientVpnEndpointID, targetNetworkCidr, accessGroupID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ec2.ClientVpnAuthorizationRuleStatusCodeActive,
+			ec2.ClientVpnAuthorizationRuleStatusCodeRevoking,
+		},
+		Target:  []string{clientVpnAuthorizationRuleStatusCodeRevoked},
+		Refresh: ec2ClientVpnAuthorizationRuleRefreshFunc(conn, clientVpnEndpointID, targetNetworkCidr, accessGroupID),
+		Timeout: timeout,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 Client VPN Endpoint (%s) Authorization Rule (%s) revocation", clientVpnEndpointID, targetNetworkCidr)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForEc2ClientVpnRouteCreation(conn *ec2.EC2, clientVpnEndpointID, targetSubnetID, destinationCidr string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.ClientVpnRouteStatusCodeCreating},
+		Target:  []string{ec2.ClientVpnRouteStatusCodeActive},
+		Refresh: ec2ClientVpnRouteRefreshFunc(conn, clientVpnEndpointID, targetSubnetID, destinationCidr),
+		Timeout: timeout,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 Client VPN Endpoint (%s) Route (%s) creation", clientVpnEndpointID, destinationCidr)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForEc2ClientVpnRouteDeletion(conn *ec2.EC2, clientVpnEndpointID, targetSubnetID, destinationCidr string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ec2.ClientVpnRouteStatusCodeActive,
+			ec2.ClientVpnRouteStatusCodeDeleting,
+		},
+		Target:  []string{clientVpnRouteStatusCodeDeleted},
+		Refresh: ec2ClientVpnRouteRefreshFunc(conn, clientVpnEndpointID, targetSubnetID, destinationCidr),
+		Timeout: timeout,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 Client VPN Endpoint (%s) Route (%s) deletion", clientVpnEndpointID, destinationCidr)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
@@ -0,0 +1,122 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func ec2DescribeHost(conn *ec2.EC2, hostID string) (*ec2.Host, error) {
+	input := &ec2.DescribeHostsInput{
+		HostIds: []*string{aws.String(hostID)},
+	}
+
+	log.Printf("[DEBUG] Reading EC2 Host (%s): %s", hostID, input)
+	for {
+		output, err := conn.DescribeHosts(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil || len(output.Hosts) == 0 {
+			return nil, nil
+		}
+
+		for _, host := range output.Hosts {
+			if host == nil {
+				continue
+			}
+
+			if aws.StringValue(host.HostId) == hostID {
+				return host, nil
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return nil, nil
+}
+
+func ec2HostRefreshFunc(conn *ec2.EC2, hostID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		host, err := ec2DescribeHost(conn, hostID)
+
+		if isAWSErr(err, "InvalidHostID.NotFound", "") {
+			return nil, ec2.AllocationStateReleased, nil
+		}
+
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading EC2 Host (%s): %s", hostID, err)
+		}
+
+		if host == nil {
+			return nil, ec2.AllocationStateReleased, nil
+		}
+
+		return host, aws.StringValue(host.State), nil
+	}
+}
+
+func expandEc2HostTagSpecifications(m map[string]interface{}) []*ec2.TagSpecification {
+	if len(m) == 0 {
+		return nil
+	}
+
+	return []*ec2.TagSpecification{
+		{
+			ResourceType: aws.String(ec2.ResourceTypeDedicatedHost),
+			Tags:         tagsFromMap(m),
+		},
+	}
+}
+
+func waitForEc2HostAvailability(conn *ec2.EC2, hostID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.AllocationStatePending},
+		Target:  []string{ec2.AllocationStateAvailable},
+		Refresh: ec2HostRefreshFunc(conn, hostID),
+		Timeout: 10 * time.Minute,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 Host (%s) availability", hostID)
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForEc2HostRelease(conn *ec2.EC2, hostID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ec2.AllocationStateAvailable,
+			ec2.AllocationStatePending,
+			ec2.AllocationStatePermanentFailure,
+			ec2.AllocationStateUnderAssessment,
+		},
+		Target: []string{
+			ec2.AllocationStateReleased,
+			ec2.AllocationStateReleasedPermanentFailure,
+		},
+		Refresh:        ec2HostRefreshFunc(conn, hostID),
+		Timeout:        10 * time.Minute,
+		NotFoundChecks: 1,
+	}
+
+	log.Printf("[DEBUG] Waiting for EC2 Host (%s) release", hostID)
+	_, err := stateConf.WaitForState()
+
+	if isResourceNotFoundError(err) {
+		return nil
+	}
+
+	return err
+}
@@ -0,0 +1,229 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+func decodeEc2TrafficMirrorFilterRuleID(id string) (string, string, error) {
+	parts := strings.Split(id, ":")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected tmf-ID:tmfr-ID", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func ec2DescribeTrafficMirrorFilter(conn *ec2.EC2, trafficMirrorFilterID string) (*ec2.TrafficMirrorFilter, error) {
+	input := &ec2.DescribeTrafficMirrorFiltersInput{
+		TrafficMirrorFilterIds: []*string{aws.String(trafficMirrorFilterID)},
+	}
+
+	log.Printf("[DEBUG] Reading EC2 Traffic Mirror Filter (%s): %s", trafficMirrorFilterID, input)
+	for {
+		output, err := conn.DescribeTrafficMirrorFilters(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil || len(output.TrafficMirrorFilters) == 0 {
+			return nil, nil
+		}
+
+		for _, trafficMirrorFilter := range output.TrafficMirrorFilters {
+			if trafficMirrorFilter == nil {
+				continue
+			}
+
+			if aws.StringValue(trafficMirrorFilter.TrafficMirrorFilterId) == trafficMirrorFilterID {
+				return trafficMirrorFilter, nil
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return nil, nil
+}
+
+func ec2DescribeTrafficMirrorFilterRule(conn *ec2.EC2, trafficMirrorFilterID, trafficMirrorFilterRuleID string) (*ec2.TrafficMirrorFilterRule, error) {
+	trafficMirrorFilter, err := ec2DescribeTrafficMirrorFilter(conn, trafficMirrorFilterID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if trafficMirrorFilter == nil {
+		return nil, nil
+	}
+
+	rules := append(trafficMirrorFilter.IngressFilterRules, trafficMirrorFilter.EgressFilterRules...)
+
+	for _, rule := range rules {
+		if rule == nil {
+			continue
+		}
+
+		if aws.StringValue(rule.TrafficMirrorFilterRuleId) == trafficMirrorFilterRuleID {
+			return rule, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func ec2DescribeTrafficMirrorSession(conn *ec2.EC2, trafficMirrorSessionID string) (*ec2.TrafficMirrorSession, error) {
+	input := &ec2.DescribeTrafficMirrorSessionsInput{
+		TrafficMirrorSessionIds: []*string{aws.String(trafficMirrorSessionID)},
+	}
+
+	log.Printf("[DEBUG] Reading EC2 Traffic Mirror Session (%s): %s", trafficMirrorSessionID, input)
+	for {
+		output, err := conn.DescribeTrafficMirrorSessions(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil || len(output.TrafficMirrorSessions) == 0 {
+			return nil, nil
+		}
+
+		for _, trafficMirrorSession := range output.TrafficMirrorSessions {
+			if trafficMirrorSession == nil {
+				continue
+			}
+
+			if aws.StringValue(trafficMirrorSession.TrafficMirrorSessionId) == trafficMirrorSessionID {
+				return trafficMirrorSession, nil
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return nil, nil
+}
+
+func ec2DescribeTrafficMirrorTarget(conn *ec2.EC2, trafficMirrorTargetID string) (*ec2.TrafficMirrorTarget, error) {
+	input := &ec2.DescribeTrafficMirrorTargetsInput{
+		TrafficMirrorTargetIds: []*string{aws.String(trafficMirrorTargetID)},
+	}
+
+	log.Printf("[DEBUG] Reading EC2 Traffic Mirror Target (%s): %s", trafficMirrorTargetID, input)
+	for {
+		output, err := conn.DescribeTrafficMirrorTargets(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil || len(output.TrafficMirrorTargets) == 0 {
+			return nil, nil
+		}
+
+		for _, trafficMirrorTarget := range output.TrafficMirrorTargets {
+			if trafficMirrorTarget == nil {
+				continue
+			}
+
+			if aws.StringValue(trafficMirrorTarget.TrafficMirrorTargetId) == trafficMirrorTargetID {
+				return trafficMirrorTarget, nil
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return nil, nil
+}
+
+func expandEc2TrafficMirrorFilterTagSpecifications(m map[string]interface{}) []*ec2.TagSpecification {
+	if len(m) == 0 {
+		return nil
+	}
+
+	return []*ec2.TagSpecification{
+		{
+			ResourceType: aws.String(ec2.ResourceTypeTrafficMirrorFilter),
+			Tags:         tagsFromMap(m),
+		},
+	}
+}
+
+func expandEc2TrafficMirrorSessionTagSpecifications(m map[string]interface{}) []*ec2.TagSpecification {
+	if len(m) == 0 {
+		return nil
+	}
+
+	return []*ec2.TagSpecification{
+		{
+			ResourceType: aws.String(ec2.ResourceTypeTrafficMirrorSession),
+			Tags:         tagsFromMap(m),
+		},
+	}
+}
+
+func expandEc2TrafficMirrorTargetTagSpecifications(m map[string]interface{}) []*ec2.TagSpecification {
+	if len(m) == 0 {
+		return nil
+	}
+
+	return []*ec2.TagSpecification{
+		{
+			ResourceType: aws.String(ec2.ResourceTypeTrafficMirrorTarget),
+			Tags:         tagsFromMap(m),
+		},
+	}
+}
+
+func expandEc2TrafficMirrorPortRangeRequest(l []interface{}) *ec2.TrafficMirrorPortRangeRequest {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	portRange := &ec2.TrafficMirrorPortRangeRequest{}
+
+	if v, ok := m["from_port"].(int); ok {
+		portRange.FromPort = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["to_port"].(int); ok {
+		portRange.ToPort = aws.Int64(int64(v))
+	}
+
+	return portRange
+}
+
+func flattenEc2TrafficMirrorPortRange(portRange *ec2.TrafficMirrorPortRange) []interface{} {
+	if portRange == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"from_port": int(aws.Int64Value(portRange.FromPort)),
+		"to_port":   int(aws.Int64Value(portRange.ToPort)),
+	}
+
+	return []interface{}{m}
+}
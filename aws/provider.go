@@ -178,6 +178,8 @@ func Provider() terraform.ResourceProvider {
 			"aws_ebs_snapshot":                              dataSourceAwsEbsSnapshot(),
 			"aws_ebs_snapshot_ids":                          dataSourceAwsEbsSnapshotIds(),
 			"aws_ebs_volume":                                dataSourceAwsEbsVolume(),
+			"aws_ec2_client_vpn_client_configuration":       dataSourceAwsEc2ClientVpnClientConfiguration(),
+			"aws_ec2_host":                                  dataSourceAwsEc2Host(),
 			"aws_ec2_transit_gateway":                       dataSourceAwsEc2TransitGateway(),
 			"aws_ec2_transit_gateway_dx_gateway_attachment": dataSourceAwsEc2TransitGatewayDxGatewayAttachment(),
 			"aws_ec2_transit_gateway_route_table":           dataSourceAwsEc2TransitGatewayRouteTable(),
@@ -211,6 +213,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_iam_policy_document":                       dataSourceAwsIamPolicyDocument(),
 			"aws_iam_role":                                  dataSourceAwsIAMRole(),
 			"aws_iam_server_certificate":                    dataSourceAwsIAMServerCertificate(),
+			"aws_iam_service_last_accessed_details":         dataSourceAwsIAMServiceLastAccessedDetails(),
 			"aws_iam_user":                                  dataSourceAwsIAMUser(),
 			"aws_internet_gateway":                          dataSourceAwsInternetGateway(),
 			"aws_iot_endpoint":                              dataSourceAwsIotEndpoint(),
@@ -219,6 +222,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_instances":                                 dataSourceAwsInstances(),
 			"aws_ip_ranges":                                 dataSourceAwsIPRanges(),
 			"aws_kinesis_stream":                            dataSourceAwsKinesisStream(),
+			"aws_kinesis_stream_consumer":                   dataSourceAwsKinesisStreamConsumer(),
 			"aws_kms_alias":                                 dataSourceAwsKmsAlias(),
 			"aws_kms_ciphertext":                            dataSourceAwsKmsCiphertext(),
 			"aws_kms_key":                                   dataSourceAwsKmsKey(),
@@ -248,6 +252,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_route_table":                               dataSourceAwsRouteTable(),
 			"aws_route_tables":                              dataSourceAwsRouteTables(),
 			"aws_route53_delegation_set":                    dataSourceAwsDelegationSet(),
+			"aws_route53_traffic_policy_document":           dataSourceAwsRoute53TrafficPolicyDocument(),
 			"aws_route53_zone":                              dataSourceAwsRoute53Zone(),
 			"aws_s3_bucket":                                 dataSourceAwsS3Bucket(),
 			"aws_s3_bucket_object":                          dataSourceAwsS3BucketObject(),
@@ -349,6 +354,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_cloudfront_origin_access_identity":                   resourceAwsCloudFrontOriginAccessIdentity(),
 			"aws_cloudfront_public_key":                               resourceAwsCloudFrontPublicKey(),
 			"aws_cloudtrail":                                          resourceAwsCloudTrail(),
+			"aws_cloudwatch_event_bus":                                resourceAwsCloudWatchEventBus(),
 			"aws_cloudwatch_event_permission":                         resourceAwsCloudWatchEventPermission(),
 			"aws_cloudwatch_event_rule":                               resourceAwsCloudWatchEventRule(),
 			"aws_cloudwatch_event_target":                             resourceAwsCloudWatchEventTarget(),
@@ -376,6 +382,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_cloudhsm_v2_hsm":                                     resourceAwsCloudHsm2Hsm(),
 			"aws_cognito_resource_server":                             resourceAwsCognitoResourceServer(),
 			"aws_cloudwatch_metric_alarm":                             resourceAwsCloudWatchMetricAlarm(),
+			"aws_cloudwatch_metric_anomaly_detector":                  resourceAwsCloudWatchMetricAnomalyDetector(),
 			"aws_cloudwatch_dashboard":                                resourceAwsCloudWatchDashboard(),
 			"aws_codedeploy_app":                                      resourceAwsCodeDeployApp(),
 			"aws_codedeploy_deployment_config":                        resourceAwsCodeDeployDeploymentConfig(),
@@ -443,9 +450,16 @@ func Provider() terraform.ResourceProvider {
 			"aws_ebs_snapshot_copy":                                   resourceAwsEbsSnapshotCopy(),
 			"aws_ebs_volume":                                          resourceAwsEbsVolume(),
 			"aws_ec2_capacity_reservation":                            resourceAwsEc2CapacityReservation(),
+			"aws_ec2_client_vpn_authorization_rule":                   resourceAwsEc2ClientVpnAuthorizationRule(),
 			"aws_ec2_client_vpn_endpoint":                             resourceAwsEc2ClientVpnEndpoint(),
 			"aws_ec2_client_vpn_network_association":                  resourceAwsEc2ClientVpnNetworkAssociation(),
+			"aws_ec2_client_vpn_route":                                resourceAwsEc2ClientVpnRoute(),
 			"aws_ec2_fleet":                                           resourceAwsEc2Fleet(),
+			"aws_ec2_host":                                            resourceAwsEc2Host(),
+			"aws_ec2_traffic_mirror_filter":                           resourceAwsEc2TrafficMirrorFilter(),
+			"aws_ec2_traffic_mirror_filter_rule":                      resourceAwsEc2TrafficMirrorFilterRule(),
+			"aws_ec2_traffic_mirror_session":                          resourceAwsEc2TrafficMirrorSession(),
+			"aws_ec2_traffic_mirror_target":                           resourceAwsEc2TrafficMirrorTarget(),
 			"aws_ec2_transit_gateway":                                 resourceAwsEc2TransitGateway(),
 			"aws_ec2_transit_gateway_route":                           resourceAwsEc2TransitGatewayRoute(),
 			"aws_ec2_transit_gateway_route_table":                     resourceAwsEc2TransitGatewayRouteTable(),
@@ -498,10 +512,14 @@ func Provider() terraform.ResourceProvider {
 			"aws_glue_classifier":                                     resourceAwsGlueClassifier(),
 			"aws_glue_connection":                                     resourceAwsGlueConnection(),
 			"aws_glue_crawler":                                        resourceAwsGlueCrawler(),
+			"aws_glue_data_catalog_encryption_settings":               resourceAwsGlueDataCatalogEncryptionSettings(),
 			"aws_glue_job":                                            resourceAwsGlueJob(),
+			"aws_glue_partition":                                      resourceAwsGluePartition(),
 			"aws_glue_security_configuration":                         resourceAwsGlueSecurityConfiguration(),
 			"aws_glue_trigger":                                        resourceAwsGlueTrigger(),
+			"aws_glue_workflow":                                       resourceAwsGlueWorkflow(),
 			"aws_guardduty_detector":                                  resourceAwsGuardDutyDetector(),
+			"aws_guardduty_filter":                                    resourceAwsGuardDutyFilter(),
 			"aws_guardduty_invite_accepter":                           resourceAwsGuardDutyInviteAccepter(),
 			"aws_guardduty_ipset":                                     resourceAwsGuardDutyIpset(),
 			"aws_guardduty_member":                                    resourceAwsGuardDutyMember(),
@@ -523,12 +541,16 @@ func Provider() terraform.ResourceProvider {
 			"aws_iam_saml_provider":                                   resourceAwsIamSamlProvider(),
 			"aws_iam_server_certificate":                              resourceAwsIAMServerCertificate(),
 			"aws_iam_service_linked_role":                             resourceAwsIamServiceLinkedRole(),
+			"aws_iam_service_specific_credential":                     resourceAwsIamServiceSpecificCredential(),
+			"aws_iam_signing_certificate":                             resourceAwsIamSigningCertificate(),
 			"aws_iam_user_group_membership":                           resourceAwsIamUserGroupMembership(),
 			"aws_iam_user_policy_attachment":                          resourceAwsIamUserPolicyAttachment(),
 			"aws_iam_user_policy":                                     resourceAwsIamUserPolicy(),
 			"aws_iam_user_ssh_key":                                    resourceAwsIamUserSshKey(),
 			"aws_iam_user":                                            resourceAwsIamUser(),
 			"aws_iam_user_login_profile":                              resourceAwsIamUserLoginProfile(),
+			"aws_iam_virtual_mfa_device":                              resourceAwsIamVirtualMfaDevice(),
+			"aws_iam_virtual_mfa_device_association":                  resourceAwsIamVirtualMfaDeviceAssociation(),
 			"aws_inspector_assessment_target":                         resourceAWSInspectorAssessmentTarget(),
 			"aws_inspector_assessment_template":                       resourceAWSInspectorAssessmentTemplate(),
 			"aws_inspector_resource_group":                            resourceAWSInspectorResourceGroup(),
@@ -545,8 +567,10 @@ func Provider() terraform.ResourceProvider {
 			"aws_key_pair":                                            resourceAwsKeyPair(),
 			"aws_kinesis_firehose_delivery_stream":                    resourceAwsKinesisFirehoseDeliveryStream(),
 			"aws_kinesis_stream":                                      resourceAwsKinesisStream(),
+			"aws_kinesis_stream_consumer":                             resourceAwsKinesisStreamConsumer(),
 			"aws_kinesis_analytics_application":                       resourceAwsKinesisAnalyticsApplication(),
 			"aws_kms_alias":                                           resourceAwsKmsAlias(),
+			"aws_kms_custom_key_store":                                resourceAwsKmsCustomKeyStore(),
 			"aws_kms_external_key":                                    resourceAwsKmsExternalKey(),
 			"aws_kms_grant":                                           resourceAwsKmsGrant(),
 			"aws_kms_key":                                             resourceAwsKmsKey(),
@@ -560,9 +584,15 @@ func Provider() terraform.ResourceProvider {
 			"aws_launch_template":                                     resourceAwsLaunchTemplate(),
 			"aws_licensemanager_association":                          resourceAwsLicenseManagerAssociation(),
 			"aws_licensemanager_license_configuration":                resourceAwsLicenseManagerLicenseConfiguration(),
+			"aws_lightsail_disk":                                      resourceAwsLightsailDisk(),
+			"aws_lightsail_disk_attachment":                           resourceAwsLightsailDiskAttachment(),
 			"aws_lightsail_domain":                                    resourceAwsLightsailDomain(),
 			"aws_lightsail_instance":                                  resourceAwsLightsailInstance(),
 			"aws_lightsail_key_pair":                                  resourceAwsLightsailKeyPair(),
+			"aws_lightsail_lb":                                        resourceAwsLightsailLoadBalancer(),
+			"aws_lightsail_lb_attachment":                             resourceAwsLightsailLoadBalancerAttachment(),
+			"aws_lightsail_lb_certificate":                            resourceAwsLightsailLoadBalancerCertificate(),
+			"aws_lightsail_lb_certificate_attachment":                 resourceAwsLightsailLoadBalancerCertificateAttachment(),
 			"aws_lightsail_static_ip":                                 resourceAwsLightsailStaticIp(),
 			"aws_lightsail_static_ip_attachment":                      resourceAwsLightsailStaticIpAttachment(),
 			"aws_lb_cookie_stickiness_policy":                         resourceAwsLBCookieStickinessPolicy(),
@@ -640,6 +670,8 @@ func Provider() terraform.ResourceProvider {
 			"aws_route53_resolver_endpoint":                           resourceAwsRoute53ResolverEndpoint(),
 			"aws_route53_resolver_rule_association":                   resourceAwsRoute53ResolverRuleAssociation(),
 			"aws_route53_resolver_rule":                               resourceAwsRoute53ResolverRule(),
+			"aws_route53_traffic_policy":                              resourceAwsRoute53TrafficPolicy(),
+			"aws_route53_traffic_policy_instance":                     resourceAwsRoute53TrafficPolicyInstance(),
 			"aws_route":                                               resourceAwsRoute(),
 			"aws_route_table":                                         resourceAwsRouteTable(),
 			"aws_default_route_table":                                 resourceAwsDefaultRouteTable(),
@@ -672,15 +704,28 @@ func Provider() terraform.ResourceProvider {
 			"aws_s3_bucket_object":                                    resourceAwsS3BucketObject(),
 			"aws_s3_bucket_notification":                              resourceAwsS3BucketNotification(),
 			"aws_s3_bucket_metric":                                    resourceAwsS3BucketMetric(),
+			"aws_s3_bucket_analytics_configuration":                   resourceAwsS3BucketAnalyticsConfiguration(),
 			"aws_s3_bucket_inventory":                                 resourceAwsS3BucketInventory(),
+			"aws_s3control_job":                                       resourceAwsS3ControlJob(),
 			"aws_security_group":                                      resourceAwsSecurityGroup(),
 			"aws_network_interface_sg_attachment":                     resourceAwsNetworkInterfaceSGAttachment(),
 			"aws_default_security_group":                              resourceAwsDefaultSecurityGroup(),
 			"aws_security_group_rule":                                 resourceAwsSecurityGroupRule(),
 			"aws_securityhub_account":                                 resourceAwsSecurityHubAccount(),
+			"aws_securityhub_action_target":                           resourceAwsSecurityHubActionTarget(),
+			"aws_securityhub_invite_accepter":                         resourceAwsSecurityHubInviteAccepter(),
+			"aws_securityhub_member":                                  resourceAwsSecurityHubMember(),
 			"aws_securityhub_product_subscription":                    resourceAwsSecurityHubProductSubscription(),
 			"aws_securityhub_standards_subscription":                  resourceAwsSecurityHubStandardsSubscription(),
+			"aws_servicecatalog_launch_constraint":                    resourceAwsServiceCatalogLaunchConstraint(),
 			"aws_servicecatalog_portfolio":                            resourceAwsServiceCatalogPortfolio(),
+			"aws_servicecatalog_principal_portfolio_association":      resourceAwsServiceCatalogPrincipalPortfolioAssociation(),
+			"aws_servicecatalog_product":                              resourceAwsServiceCatalogProduct(),
+			"aws_servicecatalog_product_portfolio_association":        resourceAwsServiceCatalogProductPortfolioAssociation(),
+			"aws_servicecatalog_provisioning_artifact":                resourceAwsServiceCatalogProvisioningArtifact(),
+			"aws_servicecatalog_template_constraint":                  resourceAwsServiceCatalogTemplateConstraint(),
+			"aws_servicequotas_service_quota":                         resourceAwsServiceQuotasServiceQuota(),
+			"aws_servicequotas_template":                              resourceAwsServiceQuotasTemplate(),
 			"aws_service_discovery_http_namespace":                    resourceAwsServiceDiscoveryHttpNamespace(),
 			"aws_service_discovery_private_dns_namespace":             resourceAwsServiceDiscoveryPrivateDnsNamespace(),
 			"aws_service_discovery_public_dns_namespace":              resourceAwsServiceDiscoveryPublicDnsNamespace(),
@@ -693,15 +738,19 @@ func Provider() terraform.ResourceProvider {
 			"aws_ssm_maintenance_window":                              resourceAwsSsmMaintenanceWindow(),
 			"aws_ssm_maintenance_window_target":                       resourceAwsSsmMaintenanceWindowTarget(),
 			"aws_ssm_maintenance_window_task":                         resourceAwsSsmMaintenanceWindowTask(),
+			"aws_ssm_ops_item":                                        resourceAwsSsmOpsItem(),
 			"aws_ssm_patch_baseline":                                  resourceAwsSsmPatchBaseline(),
 			"aws_ssm_patch_group":                                     resourceAwsSsmPatchGroup(),
 			"aws_ssm_parameter":                                       resourceAwsSsmParameter(),
 			"aws_ssm_resource_data_sync":                              resourceAwsSsmResourceDataSync(),
+			"aws_ssm_service_setting":                                 resourceAwsSsmServiceSetting(),
 			"aws_storagegateway_cache":                                resourceAwsStorageGatewayCache(),
 			"aws_storagegateway_cached_iscsi_volume":                  resourceAwsStorageGatewayCachedIscsiVolume(),
 			"aws_storagegateway_gateway":                              resourceAwsStorageGatewayGateway(),
 			"aws_storagegateway_nfs_file_share":                       resourceAwsStorageGatewayNfsFileShare(),
 			"aws_storagegateway_smb_file_share":                       resourceAwsStorageGatewaySmbFileShare(),
+			"aws_storagegateway_stored_iscsi_volume":                  resourceAwsStorageGatewayStoredIscsiVolume(),
+			"aws_storagegateway_tape":                                 resourceAwsStorageGatewayTape(),
 			"aws_storagegateway_upload_buffer":                        resourceAwsStorageGatewayUploadBuffer(),
 			"aws_storagegateway_working_storage":                      resourceAwsStorageGatewayWorkingStorage(),
 			"aws_spot_datafeed_subscription":                          resourceAwsSpotDataFeedSubscription(),
@@ -719,7 +768,9 @@ func Provider() terraform.ResourceProvider {
 			"aws_sfn_state_machine":                                   resourceAwsSfnStateMachine(),
 			"aws_default_subnet":                                      resourceAwsDefaultSubnet(),
 			"aws_subnet":                                              resourceAwsSubnet(),
+			"aws_swf_activity_type":                                   resourceAwsSwfActivityType(),
 			"aws_swf_domain":                                          resourceAwsSwfDomain(),
+			"aws_swf_workflow_type":                                   resourceAwsSwfWorkflowType(),
 			"aws_transfer_server":                                     resourceAwsTransferServer(),
 			"aws_transfer_ssh_key":                                    resourceAwsTransferSshKey(),
 			"aws_transfer_user":                                       resourceAwsTransferUser(),
@@ -781,10 +832,14 @@ func Provider() terraform.ResourceProvider {
 			"aws_pinpoint_apns_voip_channel":                          resourceAwsPinpointAPNSVoipChannel(),
 			"aws_pinpoint_apns_voip_sandbox_channel":                  resourceAwsPinpointAPNSVoipSandboxChannel(),
 			"aws_pinpoint_baidu_channel":                              resourceAwsPinpointBaiduChannel(),
+			"aws_pinpoint_campaign":                                   resourceAwsPinpointCampaign(),
 			"aws_pinpoint_email_channel":                              resourceAwsPinpointEmailChannel(),
 			"aws_pinpoint_event_stream":                               resourceAwsPinpointEventStream(),
 			"aws_pinpoint_gcm_channel":                                resourceAwsPinpointGCMChannel(),
+			"aws_pinpoint_segment":                                    resourceAwsPinpointSegment(),
 			"aws_pinpoint_sms_channel":                                resourceAwsPinpointSMSChannel(),
+			"aws_xray_encryption_config":                              resourceAwsXrayEncryptionConfig(),
+			"aws_xray_group":                                          resourceAwsXrayGroup(),
 			"aws_xray_sampling_rule":                                  resourceAwsXraySamplingRule(),
 
 			// ALBs are actually LBs because they can be type `network` or `application`
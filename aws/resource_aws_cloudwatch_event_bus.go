@@ -0,0 +1,122 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsCloudWatchEventBus() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudWatchEventBusCreate,
+		Read:   resourceAwsCloudWatchEventBusRead,
+		Delete: resourceAwsCloudWatchEventBusDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"event_source_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCloudWatchEventBusName,
+			},
+		},
+	}
+}
+
+func resourceAwsCloudWatchEventBusCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatcheventsconn
+
+	name := d.Get("name").(string)
+
+	input := &events.CreateEventBusInput{
+		Name: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("event_source_name"); ok {
+		input.EventSourceName = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating CloudWatch Event Bus: %s", input)
+	if _, err := conn.CreateEventBus(input); err != nil {
+		return fmt.Errorf("error creating CloudWatch Event Bus (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsCloudWatchEventBusRead(d, meta)
+}
+
+func resourceAwsCloudWatchEventBusRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatcheventsconn
+
+	input := &events.DescribeEventBusInput{
+		Name: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Reading CloudWatch Event Bus: %s", input)
+	output, err := conn.DescribeEventBus(input)
+
+	if isAWSErr(err, events.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] CloudWatch Event Bus (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudWatch Event Bus (%s): %s", d.Id(), err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("error reading CloudWatch Event Bus (%s): empty response", d.Id())
+	}
+
+	d.Set("arn", output.Arn)
+	d.Set("name", output.Name)
+
+	// The API does not return the event source, but partner event buses
+	// must be named after the partner event source they are associated with.
+	if name := aws.StringValue(output.Name); strings.HasPrefix(name, "aws.partner/") {
+		d.Set("event_source_name", name)
+	}
+
+	return nil
+}
+
+func resourceAwsCloudWatchEventBusDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatcheventsconn
+
+	input := &events.DeleteEventBusInput{
+		Name: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting CloudWatch Event Bus: %s", input)
+	_, err := conn.DeleteEventBus(input)
+
+	if isAWSErr(err, events.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting CloudWatch Event Bus (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,307 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	events "github.com/aws/aws-sdk-go/service/cloudwatchevents"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func init() {
+	resource.AddTestSweepers("aws_cloudwatch_event_bus", &resource.Sweeper{
+		Name: "aws_cloudwatch_event_bus",
+		F:    testSweepCloudWatchEventBuses,
+	})
+}
+
+func testSweepCloudWatchEventBuses(region string) error {
+	client, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("Error getting client: %s", err)
+	}
+	conn := client.(*AWSClient).cloudwatcheventsconn
+
+	input := &events.ListEventBusesInput{
+		NamePrefix: aws.String("tf-acc-test"),
+	}
+
+	for {
+		output, err := conn.ListEventBuses(input)
+
+		if testSweepSkipSweepError(err) {
+			log.Printf("[WARN] Skipping CloudWatch Event Bus sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("Error retrieving CloudWatch Event Buses: %s", err)
+		}
+
+		for _, eventBus := range output.EventBuses {
+			name := aws.StringValue(eventBus.Name)
+
+			if err := testSweepCloudWatchEventBusRules(conn, name); err != nil {
+				return err
+			}
+
+			log.Printf("[INFO] Deleting CloudWatch Event Bus: %s", name)
+			_, err := conn.DeleteEventBus(&events.DeleteEventBusInput{
+				Name: aws.String(name),
+			})
+
+			if err != nil {
+				return fmt.Errorf("Error deleting CloudWatch Event Bus (%s): %s", name, err)
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return nil
+}
+
+// testSweepCloudWatchEventBusRules removes the rules and targets of a custom event bus,
+// which must be empty before it can be deleted.
+func testSweepCloudWatchEventBusRules(conn *events.CloudWatchEvents, eventBusName string) error {
+	input := &events.ListRulesInput{
+		EventBusName: aws.String(eventBusName),
+	}
+
+	for {
+		output, err := conn.ListRules(input)
+
+		if err != nil {
+			return fmt.Errorf("Error retrieving CloudWatch Event Bus (%s) Rules: %s", eventBusName, err)
+		}
+
+		for _, rule := range output.Rules {
+			ruleName := aws.StringValue(rule.Name)
+
+			listTargetsByRuleOutput, err := conn.ListTargetsByRule(&events.ListTargetsByRuleInput{
+				EventBusName: aws.String(eventBusName),
+				Limit:        aws.Int64(100), // Set limit to allowed maximum to prevent API throttling
+				Rule:         aws.String(ruleName),
+			})
+
+			if err != nil {
+				return fmt.Errorf("Error retrieving CloudWatch Event Bus (%s) Rule (%s) Targets: %s", eventBusName, ruleName, err)
+			}
+
+			var targetIDs []*string
+			for _, target := range listTargetsByRuleOutput.Targets {
+				targetIDs = append(targetIDs, target.Id)
+			}
+
+			if len(targetIDs) > 0 {
+				log.Printf("[INFO] Deleting CloudWatch Event Bus (%s) Rule (%s) Targets", eventBusName, ruleName)
+				_, err := conn.RemoveTargets(&events.RemoveTargetsInput{
+					EventBusName: aws.String(eventBusName),
+					Ids:          targetIDs,
+					Rule:         aws.String(ruleName),
+				})
+
+				if err != nil {
+					return fmt.Errorf("Error deleting CloudWatch Event Bus (%s) Rule (%s) Targets: %s", eventBusName, ruleName, err)
+				}
+			}
+
+			log.Printf("[INFO] Deleting CloudWatch Event Bus (%s) Rule: %s", eventBusName, ruleName)
+			_, err = conn.DeleteRule(&events.DeleteRuleInput{
+				EventBusName: aws.String(eventBusName),
+				Name:         aws.String(ruleName),
+			})
+
+			if err != nil {
+				return fmt.Errorf("Error deleting CloudWatch Event Bus (%s) Rule (%s): %s", eventBusName, ruleName, err)
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return nil
+}
+
+func TestAccAWSCloudWatchEventBus_basic(t *testing.T) {
+	var eventBus events.DescribeEventBusOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudwatch_event_bus.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchEventBusDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchEventBusConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventBusExists(resourceName, &eventBus),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "events", fmt.Sprintf("event-bus/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "event_source_name", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudWatchEventBus_PartnerEventSource(t *testing.T) {
+	key := "EVENT_BRIDGE_PARTNER_EVENT_SOURCE_NAME"
+	eventSourceName := os.Getenv(key)
+	if eventSourceName == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	var eventBus events.DescribeEventBusOutput
+	resourceName := "aws_cloudwatch_event_bus.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchEventBusDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchEventBusConfigPartnerEventSource(eventSourceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventBusExists(resourceName, &eventBus),
+					resource.TestCheckResourceAttr(resourceName, "event_source_name", eventSourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", eventSourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudWatchEventBus_disappears(t *testing.T) {
+	var eventBus events.DescribeEventBusOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudwatch_event_bus.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchEventBusDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchEventBusConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventBusExists(resourceName, &eventBus),
+					testAccCheckCloudWatchEventBusDisappears(&eventBus),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCloudWatchEventBusExists(resourceName string, eventBus *events.DescribeEventBusOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+
+		output, err := conn.DescribeEventBus(&events.DescribeEventBusInput{
+			Name: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("CloudWatch Event Bus (%s) not found", rs.Primary.ID)
+		}
+
+		*eventBus = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSCloudWatchEventBusDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudwatch_event_bus" {
+			continue
+		}
+
+		output, err := conn.DescribeEventBus(&events.DescribeEventBusInput{
+			Name: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, events.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil && aws.StringValue(output.Name) == rs.Primary.ID {
+			return fmt.Errorf("CloudWatch Event Bus (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckCloudWatchEventBusDisappears(eventBus *events.DescribeEventBusOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
+
+		_, err := conn.DeleteEventBus(&events.DeleteEventBusInput{
+			Name: eventBus.Name,
+		})
+
+		return err
+	}
+}
+
+func testAccAWSCloudWatchEventBusConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_bus" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSCloudWatchEventBusConfigPartnerEventSource(eventSourceName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_bus" "test" {
+  name              = %[1]q
+  event_source_name = %[1]q
+}
+`, eventSourceName)
+}
@@ -23,7 +23,7 @@ func resourceAwsCloudWatchEventPermission() *schema.Resource {
 		Update: resourceAwsCloudWatchEventPermissionUpdate,
 		Delete: resourceAwsCloudWatchEventPermissionDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceAwsCloudWatchEventPermissionImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -57,6 +57,13 @@ func resourceAwsCloudWatchEventPermission() *schema.Resource {
 					},
 				},
 			},
+			"event_bus_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      cloudWatchEventsDefaultEventBusName,
+				ValidateFunc: validateCloudWatchEventBusName,
+			},
 			"principal": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -75,13 +82,15 @@ func resourceAwsCloudWatchEventPermission() *schema.Resource {
 func resourceAwsCloudWatchEventPermissionCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cloudwatcheventsconn
 
+	eventBusName := d.Get("event_bus_name").(string)
 	statementID := d.Get("statement_id").(string)
 
 	input := events.PutPermissionInput{
-		Action:      aws.String(d.Get("action").(string)),
-		Condition:   expandCloudWatchEventsCondition(d.Get("condition").([]interface{})),
-		Principal:   aws.String(d.Get("principal").(string)),
-		StatementId: aws.String(statementID),
+		Action:       aws.String(d.Get("action").(string)),
+		Condition:    expandCloudWatchEventsCondition(d.Get("condition").([]interface{})),
+		EventBusName: aws.String(eventBusName),
+		Principal:    aws.String(d.Get("principal").(string)),
+		StatementId:  aws.String(statementID),
 	}
 
 	log.Printf("[DEBUG] Creating CloudWatch Events permission: %s", input)
@@ -90,7 +99,7 @@ func resourceAwsCloudWatchEventPermissionCreate(d *schema.ResourceData, meta int
 		return fmt.Errorf("Creating CloudWatch Events permission failed: %s", err.Error())
 	}
 
-	d.SetId(statementID)
+	d.SetId(cloudWatchEventPermissionID(eventBusName, statementID))
 
 	return resourceAwsCloudWatchEventPermissionRead(d, meta)
 }
@@ -98,14 +107,28 @@ func resourceAwsCloudWatchEventPermissionCreate(d *schema.ResourceData, meta int
 // See also: https://docs.aws.amazon.com/AmazonCloudWatchEvents/latest/APIReference/API_DescribeEventBus.html
 func resourceAwsCloudWatchEventPermissionRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cloudwatcheventsconn
-	input := events.DescribeEventBusInput{}
+
+	eventBusName, statementID, err := decodeCloudWatchEventPermissionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := events.DescribeEventBusInput{
+		Name: aws.String(eventBusName),
+	}
 	var policyDoc CloudWatchEventPermissionPolicyDoc
 	var policyStatement *CloudWatchEventPermissionPolicyStatement
 
 	// Especially with concurrent PutPermission calls there can be a slight delay
-	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
 		log.Printf("[DEBUG] Reading CloudWatch Events bus: %s", input)
 		debo, err := conn.DescribeEventBus(&input)
+		if isAWSErr(err, events.ErrCodeResourceNotFoundException, "") {
+			return resource.NonRetryableError(&resource.NotFoundError{
+				Message:     fmt.Sprintf("CloudWatch Events bus %q not found", eventBusName),
+				LastRequest: input,
+			})
+		}
 		if err != nil {
 			return resource.NonRetryableError(fmt.Errorf("Reading CloudWatch Events permission '%s' failed: %s", d.Id(), err.Error()))
 		}
@@ -124,7 +147,7 @@ func resourceAwsCloudWatchEventPermissionRead(d *schema.ResourceData, meta inter
 			return resource.NonRetryableError(fmt.Errorf("Reading CloudWatch Events permission '%s' failed: %s", d.Id(), err.Error()))
 		}
 
-		policyStatement, err = findCloudWatchEventPermissionPolicyStatementByID(&policyDoc, d.Id())
+		policyStatement, err = findCloudWatchEventPermissionPolicyStatementByID(&policyDoc, statementID)
 		return resource.RetryableError(err)
 	})
 	if err != nil {
@@ -139,6 +162,7 @@ func resourceAwsCloudWatchEventPermissionRead(d *schema.ResourceData, meta inter
 	}
 
 	d.Set("action", policyStatement.Action)
+	d.Set("event_bus_name", eventBusName)
 
 	if err := d.Set("condition", flattenCloudWatchEventPermissionPolicyStatementCondition(policyStatement.Condition)); err != nil {
 		return fmt.Errorf("error setting condition: %s", err)
@@ -164,10 +188,11 @@ func resourceAwsCloudWatchEventPermissionUpdate(d *schema.ResourceData, meta int
 	conn := meta.(*AWSClient).cloudwatcheventsconn
 
 	input := events.PutPermissionInput{
-		Action:      aws.String(d.Get("action").(string)),
-		Condition:   expandCloudWatchEventsCondition(d.Get("condition").([]interface{})),
-		Principal:   aws.String(d.Get("principal").(string)),
-		StatementId: aws.String(d.Get("statement_id").(string)),
+		Action:       aws.String(d.Get("action").(string)),
+		Condition:    expandCloudWatchEventsCondition(d.Get("condition").([]interface{})),
+		EventBusName: aws.String(d.Get("event_bus_name").(string)),
+		Principal:    aws.String(d.Get("principal").(string)),
+		StatementId:  aws.String(d.Get("statement_id").(string)),
 	}
 
 	log.Printf("[DEBUG] Update CloudWatch Events permission: %s", input)
@@ -186,12 +211,19 @@ func resourceAwsCloudWatchEventPermissionUpdate(d *schema.ResourceData, meta int
 
 func resourceAwsCloudWatchEventPermissionDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cloudwatcheventsconn
+
+	eventBusName, statementID, err := decodeCloudWatchEventPermissionID(d.Id())
+	if err != nil {
+		return err
+	}
+
 	input := events.RemovePermissionInput{
-		StatementId: aws.String(d.Id()),
+		EventBusName: aws.String(eventBusName),
+		StatementId:  aws.String(statementID),
 	}
 
 	log.Printf("[DEBUG] Delete CloudWatch Events permission: %s", input)
-	_, err := conn.RemovePermission(&input)
+	_, err = conn.RemovePermission(&input)
 	if isAWSErr(err, events.ErrCodeResourceNotFoundException, "") {
 		return nil
 	}
@@ -201,6 +233,14 @@ func resourceAwsCloudWatchEventPermissionDelete(d *schema.ResourceData, meta int
 	return nil
 }
 
+func resourceAwsCloudWatchEventPermissionImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, _, err := decodeCloudWatchEventPermissionID(d.Id()); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 // https://docs.aws.amazon.com/AmazonCloudWatchEvents/latest/APIReference/API_PutPermission.html#API_PutPermission_RequestParameters
 func validateCloudWatchEventPermissionAction(v interface{}, k string) (ws []string, es []error) {
 	value := v.(string)
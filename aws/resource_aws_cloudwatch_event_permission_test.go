@@ -248,6 +248,34 @@ func TestAccAWSCloudWatchEventPermission_Multiple(t *testing.T) {
 	})
 }
 
+func TestAccAWSCloudWatchEventPermission_EventBusName(t *testing.T) {
+	principal := "111111111111"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudwatch_event_permission.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudWatchEventPermissionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAwsCloudWatchEventPermissionResourceConfigEventBusName(principal, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventPermissionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "event_bus_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "principal", principal),
+					resource.TestCheckResourceAttr(resourceName, "statement_id", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAWSCloudWatchEventPermission_Disappears(t *testing.T) {
 	resourceName := "aws_cloudwatch_event_permission.test1"
 	principal := "111111111111"
@@ -281,11 +309,17 @@ func testAccCheckCloudWatchEventPermissionDisappears(resourceName string) resour
 			return fmt.Errorf("No resource ID is set")
 		}
 
+		eventBusName, statementID, err := decodeCloudWatchEventPermissionID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
 		conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
 		input := events.RemovePermissionInput{
-			StatementId: aws.String(rs.Primary.ID),
+			EventBusName: aws.String(eventBusName),
+			StatementId:  aws.String(statementID),
 		}
-		_, err := conn.RemovePermission(&input)
+		_, err = conn.RemovePermission(&input)
 		return err
 	}
 }
@@ -302,7 +336,14 @@ func testAccCheckCloudWatchEventPermissionExists(pr string) resource.TestCheckFu
 			return fmt.Errorf("No ID is set")
 		}
 
-		debo, err := conn.DescribeEventBus(&events.DescribeEventBusInput{})
+		eventBusName, statementID, err := decodeCloudWatchEventPermissionID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		debo, err := conn.DescribeEventBus(&events.DescribeEventBusInput{
+			Name: aws.String(eventBusName),
+		})
 		if err != nil {
 			return fmt.Errorf("Reading CloudWatch Events bus policy for '%s' failed: %s", pr, err.Error())
 		}
@@ -317,7 +358,7 @@ func testAccCheckCloudWatchEventPermissionExists(pr string) resource.TestCheckFu
 			return fmt.Errorf("Reading CloudWatch Events bus policy for '%s' failed: %s", pr, err.Error())
 		}
 
-		_, err = findCloudWatchEventPermissionPolicyStatementByID(&policyDoc, rs.Primary.ID)
+		_, err = findCloudWatchEventPermissionPolicyStatementByID(&policyDoc, statementID)
 		return err
 	}
 }
@@ -330,10 +371,20 @@ func testAccCheckCloudWatchEventPermissionDestroy(s *terraform.State) error {
 			continue
 		}
 
-		err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-			input := events.DescribeEventBusInput{}
+		eventBusName, statementID, err := decodeCloudWatchEventPermissionID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+			input := events.DescribeEventBusInput{
+				Name: aws.String(eventBusName),
+			}
 
 			debo, err := conn.DescribeEventBus(&input)
+			if isAWSErr(err, events.ErrCodeResourceNotFoundException, "") {
+				return nil
+			}
 			if err != nil {
 				return resource.NonRetryableError(err)
 			}
@@ -347,7 +398,7 @@ func testAccCheckCloudWatchEventPermissionDestroy(s *terraform.State) error {
 				return resource.NonRetryableError(fmt.Errorf("Reading CloudWatch Events permission '%s' failed: %s", rs.Primary.ID, err.Error()))
 			}
 
-			_, err = findCloudWatchEventPermissionPolicyStatementByID(&policyDoc, rs.Primary.ID)
+			_, err = findCloudWatchEventPermissionPolicyStatementByID(&policyDoc, statementID)
 			if err == nil {
 				return resource.RetryableError(fmt.Errorf("CloudWatch Events permission exists: %s", rs.Primary.ID))
 			}
@@ -410,3 +461,17 @@ resource "aws_cloudwatch_event_permission" "test2" {
 }
 `, principal1, statementID1, principal2, statementID2)
 }
+
+func testAccCheckAwsCloudWatchEventPermissionResourceConfigEventBusName(principal, rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_bus" "test" {
+  name = %[2]q
+}
+
+resource "aws_cloudwatch_event_permission" "test" {
+  event_bus_name = "${aws_cloudwatch_event_bus.test.name}"
+  principal      = %[1]q
+  statement_id   = %[2]q
+}
+`, principal, rName)
+}
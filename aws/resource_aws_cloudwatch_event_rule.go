@@ -22,7 +22,7 @@ func resourceAwsCloudWatchEventRule() *schema.Resource {
 		Update: resourceAwsCloudWatchEventRuleUpdate,
 		Delete: resourceAwsCloudWatchEventRuleDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceAwsCloudWatchEventRuleImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -64,6 +64,13 @@ func resourceAwsCloudWatchEventRule() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(0, 1600),
 			},
+			"event_bus_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      cloudWatchEventsDefaultEventBusName,
+				ValidateFunc: validateCloudWatchEventBusName,
+			},
 			"is_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -118,7 +125,7 @@ func resourceAwsCloudWatchEventRuleCreate(d *schema.ResourceData, meta interface
 	}
 
 	d.Set("arn", out.RuleArn)
-	d.SetId(*input.Name)
+	d.SetId(cloudWatchEventRuleID(aws.StringValue(input.EventBusName), aws.StringValue(input.Name)))
 
 	log.Printf("[INFO] CloudWatch Event Rule %q created", *out.RuleArn)
 
@@ -132,8 +139,14 @@ func resourceAwsCloudWatchEventRuleCreate(d *schema.ResourceData, meta interface
 func resourceAwsCloudWatchEventRuleRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cloudwatcheventsconn
 
+	eventBusName, ruleName, err := decodeCloudWatchEventRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
 	input := events.DescribeRuleInput{
-		Name: aws.String(d.Id()),
+		EventBusName: aws.String(eventBusName),
+		Name:         aws.String(ruleName),
 	}
 	log.Printf("[DEBUG] Reading CloudWatch Event Rule: %s", input)
 	out, err := conn.DescribeRule(&input)
@@ -151,6 +164,7 @@ func resourceAwsCloudWatchEventRuleRead(d *schema.ResourceData, meta interface{}
 
 	d.Set("arn", out.Arn)
 	d.Set("description", out.Description)
+	d.Set("event_bus_name", eventBusName)
 	if out.EventPattern != nil {
 		pattern, err := structure.NormalizeJsonString(*out.EventPattern)
 		if err != nil {
@@ -177,10 +191,16 @@ func resourceAwsCloudWatchEventRuleRead(d *schema.ResourceData, meta interface{}
 func resourceAwsCloudWatchEventRuleUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cloudwatcheventsconn
 
+	eventBusName, ruleName, err := decodeCloudWatchEventRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
 	if d.HasChange("is_enabled") && d.Get("is_enabled").(bool) {
 		log.Printf("[DEBUG] Enabling CloudWatch Event Rule %q", d.Id())
 		_, err := conn.EnableRule(&events.EnableRuleInput{
-			Name: aws.String(d.Id()),
+			EventBusName: aws.String(eventBusName),
+			Name:         aws.String(ruleName),
 		})
 		if err != nil {
 			return err
@@ -188,7 +208,7 @@ func resourceAwsCloudWatchEventRuleUpdate(d *schema.ResourceData, meta interface
 		log.Printf("[DEBUG] CloudWatch Event Rule (%q) enabled", d.Id())
 	}
 
-	input, err := buildPutRuleInputStruct(d, d.Id())
+	input, err := buildPutRuleInputStruct(d, ruleName)
 	if err != nil {
 		return fmt.Errorf("Updating CloudWatch Event Rule failed: %s", err)
 	}
@@ -216,7 +236,8 @@ func resourceAwsCloudWatchEventRuleUpdate(d *schema.ResourceData, meta interface
 	if d.HasChange("is_enabled") && !d.Get("is_enabled").(bool) {
 		log.Printf("[DEBUG] Disabling CloudWatch Event Rule %q", d.Id())
 		_, err := conn.DisableRule(&events.DisableRuleInput{
-			Name: aws.String(d.Id()),
+			EventBusName: aws.String(eventBusName),
+			Name:         aws.String(ruleName),
 		})
 		if err != nil {
 			return err
@@ -236,9 +257,15 @@ func resourceAwsCloudWatchEventRuleUpdate(d *schema.ResourceData, meta interface
 func resourceAwsCloudWatchEventRuleDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cloudwatcheventsconn
 
+	eventBusName, ruleName, err := decodeCloudWatchEventRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
 	log.Printf("[INFO] Deleting CloudWatch Event Rule: %s", d.Id())
-	_, err := conn.DeleteRule(&events.DeleteRuleInput{
-		Name: aws.String(d.Id()),
+	_, err = conn.DeleteRule(&events.DeleteRuleInput{
+		EventBusName: aws.String(eventBusName),
+		Name:         aws.String(ruleName),
 	})
 	if err != nil {
 		return fmt.Errorf("Error deleting CloudWatch Event Rule: %s", err)
@@ -250,7 +277,8 @@ func resourceAwsCloudWatchEventRuleDelete(d *schema.ResourceData, meta interface
 
 func buildPutRuleInputStruct(d *schema.ResourceData, name string) (*events.PutRuleInput, error) {
 	input := events.PutRuleInput{
-		Name: aws.String(name),
+		EventBusName: aws.String(d.Get("event_bus_name").(string)),
+		Name:         aws.String(name),
 	}
 	if v, ok := d.GetOk("description"); ok {
 		input.Description = aws.String(v.(string))
@@ -274,6 +302,14 @@ func buildPutRuleInputStruct(d *schema.ResourceData, name string) (*events.PutRu
 	return &input, nil
 }
 
+func resourceAwsCloudWatchEventRuleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, _, err := decodeCloudWatchEventRuleID(d.Id()); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 // State is represented as (ENABLED|DISABLED) in the API
 func getBooleanStateFromString(state string) (bool, error) {
 	if state == "ENABLED" {
@@ -240,6 +240,34 @@ func TestAccAWSCloudWatchEventRule_enable(t *testing.T) {
 	})
 }
 
+func TestAccAWSCloudWatchEventRule_EventBusName(t *testing.T) {
+	var rule events.DescribeRuleOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudwatch_event_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchEventRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchEventRuleConfigEventBusName(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventRuleExists(resourceName, &rule),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "events", fmt.Sprintf("rule/%[1]s/%[1]s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "event_bus_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckCloudWatchEventRuleExists(n string, rule *events.DescribeRuleOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -247,9 +275,15 @@ func testAccCheckCloudWatchEventRuleExists(n string, rule *events.DescribeRuleOu
 			return fmt.Errorf("Not found: %s", n)
 		}
 
+		eventBusName, ruleName, err := decodeCloudWatchEventRuleID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
 		conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
 		params := events.DescribeRuleInput{
-			Name: aws.String(rs.Primary.ID),
+			EventBusName: aws.String(eventBusName),
+			Name:         aws.String(ruleName),
 		}
 		resp, err := conn.DescribeRule(&params)
 		if err != nil {
@@ -272,9 +306,15 @@ func testAccCheckCloudWatchEventRuleEnabled(n string, desired string, rule *even
 			return fmt.Errorf("Not found: %s", n)
 		}
 
+		eventBusName, ruleName, err := decodeCloudWatchEventRuleID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
 		conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
 		params := events.DescribeRuleInput{
-			Name: aws.String(rs.Primary.ID),
+			EventBusName: aws.String(eventBusName),
+			Name:         aws.String(ruleName),
 		}
 		resp, err := conn.DescribeRule(&params)
 
@@ -297,8 +337,14 @@ func testAccCheckAWSCloudWatchEventRuleDestroy(s *terraform.State) error {
 			continue
 		}
 
+		eventBusName, ruleName, err := decodeCloudWatchEventRuleID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
 		params := events.DescribeRuleInput{
-			Name: aws.String(rs.Primary.ID),
+			EventBusName: aws.String(eventBusName),
+			Name:         aws.String(ruleName),
 		}
 
 		resp, err := conn.DescribeRule(&params)
@@ -455,3 +501,22 @@ PATTERN
 `
 
 // TODO: Figure out example with IAM Role
+
+func testAccAWSCloudWatchEventRuleConfigEventBusName(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_bus" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudwatch_event_rule" "test" {
+  name           = %[1]q
+  event_bus_name = "${aws_cloudwatch_event_bus.test.name}"
+
+  event_pattern = <<PATTERN
+{
+  "source": ["aws.partner/example.com/123456789012"]
+}
+PATTERN
+}
+`, rName)
+}
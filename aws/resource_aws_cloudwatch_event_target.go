@@ -21,8 +21,19 @@ func resourceAwsCloudWatchEventTarget() *schema.Resource {
 		Read:   resourceAwsCloudWatchEventTargetRead,
 		Update: resourceAwsCloudWatchEventTargetUpdate,
 		Delete: resourceAwsCloudWatchEventTargetDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsCloudWatchEventTargetImport,
+		},
 
 		Schema: map[string]*schema.Schema{
+			"event_bus_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      cloudWatchEventsDefaultEventBusName,
+				ValidateFunc: validateCloudWatchEventBusName,
+			},
+
 			"rule": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -248,8 +259,7 @@ func resourceAwsCloudWatchEventTargetCreate(d *schema.ResourceData, meta interfa
 			out.FailedEntries)
 	}
 
-	id := rule + "-" + targetId
-	d.SetId(id)
+	d.SetId(cloudWatchEventTargetID(d.Get("event_bus_name").(string), rule, targetId))
 
 	log.Printf("[INFO] CloudWatch Event Target %q created", d.Id())
 
@@ -259,9 +269,12 @@ func resourceAwsCloudWatchEventTargetCreate(d *schema.ResourceData, meta interfa
 func resourceAwsCloudWatchEventTargetRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cloudwatcheventsconn
 
+	eventBusName := decodeCloudWatchEventTargetEventBusName(d.Id())
+
 	t, err := findEventTargetById(
 		d.Get("target_id").(string),
 		d.Get("rule").(string),
+		eventBusName,
 		nil, conn)
 	if err != nil {
 		if regexp.MustCompile(" not found$").MatchString(err.Error()) {
@@ -290,6 +303,7 @@ func resourceAwsCloudWatchEventTargetRead(d *schema.ResourceData, meta interface
 	log.Printf("[DEBUG] Found Event Target: %s", t)
 
 	d.Set("arn", t.Arn)
+	d.Set("event_bus_name", eventBusName)
 	d.Set("target_id", t.Id)
 	d.Set("input", t.Input)
 	d.Set("input_path", t.InputPath)
@@ -334,11 +348,12 @@ func resourceAwsCloudWatchEventTargetRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func findEventTargetById(id, rule string, nextToken *string, conn *events.CloudWatchEvents) (*events.Target, error) {
+func findEventTargetById(id, rule, eventBusName string, nextToken *string, conn *events.CloudWatchEvents) (*events.Target, error) {
 	input := events.ListTargetsByRuleInput{
-		Rule:      aws.String(rule),
-		NextToken: nextToken,
-		Limit:     aws.Int64(100), // Set limit to allowed maximum to prevent API throttling
+		EventBusName: cloudWatchEventTargetEventBusNameInput(eventBusName),
+		Rule:         aws.String(rule),
+		NextToken:    nextToken,
+		Limit:        aws.Int64(100), // Set limit to allowed maximum to prevent API throttling
 	}
 	log.Printf("[DEBUG] Reading CloudWatch Event Target: %s", input)
 	out, err := conn.ListTargetsByRule(&input)
@@ -353,7 +368,7 @@ func findEventTargetById(id, rule string, nextToken *string, conn *events.CloudW
 	}
 
 	if out.NextToken != nil {
-		return findEventTargetById(id, rule, nextToken, conn)
+		return findEventTargetById(id, rule, eventBusName, out.NextToken, conn)
 	}
 
 	return nil, fmt.Errorf("CloudWatch Event Target %q (%q) not found", id, rule)
@@ -377,8 +392,9 @@ func resourceAwsCloudWatchEventTargetDelete(d *schema.ResourceData, meta interfa
 	conn := meta.(*AWSClient).cloudwatcheventsconn
 
 	input := events.RemoveTargetsInput{
-		Ids:  []*string{aws.String(d.Get("target_id").(string))},
-		Rule: aws.String(d.Get("rule").(string)),
+		EventBusName: cloudWatchEventTargetEventBusNameInput(decodeCloudWatchEventTargetEventBusName(d.Id())),
+		Ids:          []*string{aws.String(d.Get("target_id").(string))},
+		Rule:         aws.String(d.Get("rule").(string)),
 	}
 	log.Printf("[INFO] Deleting CloudWatch Event Target: %s", input)
 	_, err := conn.RemoveTargets(&input)
@@ -430,13 +446,38 @@ func buildPutTargetInputStruct(d *schema.ResourceData) *events.PutTargetsInput {
 	}
 
 	input := events.PutTargetsInput{
-		Rule:    aws.String(d.Get("rule").(string)),
-		Targets: []*events.Target{e},
+		EventBusName: cloudWatchEventTargetEventBusNameInput(d.Get("event_bus_name").(string)),
+		Rule:         aws.String(d.Get("rule").(string)),
+		Targets:      []*events.Target{e},
 	}
 
 	return &input
 }
 
+// cloudWatchEventTargetEventBusNameInput omits the event bus name from API requests
+// for the default event bus, which the API assumes when none is given.
+func cloudWatchEventTargetEventBusNameInput(eventBusName string) *string {
+	if eventBusName == "" || eventBusName == cloudWatchEventsDefaultEventBusName {
+		return nil
+	}
+
+	return aws.String(eventBusName)
+}
+
+func resourceAwsCloudWatchEventTargetImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	eventBusName, ruleName, targetID, err := decodeCloudWatchEventTargetImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(cloudWatchEventTargetID(eventBusName, ruleName, targetID))
+	d.Set("event_bus_name", eventBusName)
+	d.Set("rule", ruleName)
+	d.Set("target_id", targetID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func expandAwsCloudWatchEventTargetRunParameters(config []interface{}) *events.RunCommandParameters {
 
 	commands := make([]*events.RunCommandTarget, 0)
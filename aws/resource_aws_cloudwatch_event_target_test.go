@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -123,6 +124,81 @@ func TestAccAWSCloudWatchEventTarget_basic(t *testing.T) {
 						regexp.MustCompile(fmt.Sprintf(":%s$", snsTopicName2))),
 				),
 			},
+			{
+				ResourceName:      "aws_cloudwatch_event_target.moobar",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSCloudWatchEventTargetImportStateIdFunc("aws_cloudwatch_event_target.moobar"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudWatchEventTarget_EventBusName(t *testing.T) {
+	var target events.Target
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudwatch_event_target.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchEventTargetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchEventTargetConfigEventBusName(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventTargetExists(resourceName, &target),
+					resource.TestCheckResourceAttr(resourceName, "event_bus_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "rule", rName),
+					resource.TestCheckResourceAttr(resourceName, "target_id", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSCloudWatchEventTargetImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudWatchEventTarget_LegacyID(t *testing.T) {
+	var target events.Target
+	rName := acctest.RandString(5)
+	ruleName := fmt.Sprintf("tf-acc-cw-event-rule-legacy-id-%s", rName)
+	snsTopicName := fmt.Sprintf("tf-acc-%s", rName)
+	targetID := fmt.Sprintf("tf-acc-cw-target-%s", rName)
+	resourceName := "aws_cloudwatch_event_target.moobar"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchEventTargetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchEventTargetConfig(ruleName, snsTopicName, targetID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventTargetExists(resourceName, &target),
+					resource.TestCheckResourceAttr(resourceName, "event_bus_name", "default"),
+					testAccCheckCloudWatchEventTargetRemoveEventBusNameFromState(resourceName),
+				),
+			},
+			// State written before event bus support has no event_bus_name
+			// and must refresh without planning a replacement.
+			{
+				Config:   testAccAWSCloudWatchEventTargetConfig(ruleName, snsTopicName, targetID),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccAWSCloudWatchEventTargetConfig(ruleName, snsTopicName, targetID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchEventTargetExists(resourceName, &target),
+					resource.TestCheckResourceAttr(resourceName, "event_bus_name", "default"),
+					resource.TestCheckResourceAttr(resourceName, "rule", ruleName),
+					resource.TestCheckResourceAttr(resourceName, "target_id", targetID),
+				),
+			},
 		},
 	})
 }
@@ -302,7 +378,7 @@ func testAccCheckCloudWatchEventTargetExists(n string, rule *events.Target) reso
 
 		conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
 		t, err := findEventTargetById(rs.Primary.Attributes["target_id"],
-			rs.Primary.Attributes["rule"], nil, conn)
+			rs.Primary.Attributes["rule"], rs.Primary.Attributes["event_bus_name"], nil, conn)
 		if err != nil {
 			return fmt.Errorf("Event Target not found: %s", err)
 		}
@@ -313,6 +389,19 @@ func testAccCheckCloudWatchEventTargetExists(n string, rule *events.Target) reso
 	}
 }
 
+func testAccCheckCloudWatchEventTargetRemoveEventBusNameFromState(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		delete(rs.Primary.Attributes, "event_bus_name")
+
+		return nil
+	}
+}
+
 func testAccCheckAWSCloudWatchEventTargetDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).cloudwatcheventsconn
 
@@ -322,7 +411,7 @@ func testAccCheckAWSCloudWatchEventTargetDestroy(s *terraform.State) error {
 		}
 
 		t, err := findEventTargetById(rs.Primary.Attributes["target_id"],
-			rs.Primary.Attributes["rule"], nil, conn)
+			rs.Primary.Attributes["rule"], rs.Primary.Attributes["event_bus_name"], nil, conn)
 		if err == nil {
 			return fmt.Errorf("CloudWatch Event Target %q still exists: %s",
 				rs.Primary.ID, t)
@@ -332,6 +421,22 @@ func testAccCheckAWSCloudWatchEventTargetDestroy(s *terraform.State) error {
 	return nil
 }
 
+func testAccAWSCloudWatchEventTargetImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		parts := []string{rs.Primary.Attributes["rule"], rs.Primary.Attributes["target_id"]}
+		if eventBusName := rs.Primary.Attributes["event_bus_name"]; eventBusName != cloudWatchEventsDefaultEventBusName {
+			parts = append([]string{eventBusName}, parts...)
+		}
+
+		return strings.Join(parts, "/"), nil
+	}
+}
+
 func testAccAWSCloudWatchEventTargetConfig(ruleName, snsTopicName, targetID string) string {
 	return fmt.Sprintf(`
 resource "aws_cloudwatch_event_rule" "foo" {
@@ -927,3 +1032,33 @@ EOF
 }
 `, rName)
 }
+
+func testAccAWSCloudWatchEventTargetConfigEventBusName(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_event_bus" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudwatch_event_rule" "test" {
+  name           = %[1]q
+  event_bus_name = "${aws_cloudwatch_event_bus.test.name}"
+
+  event_pattern = <<PATTERN
+{
+  "source": ["aws.partner/example.com/123456789012"]
+}
+PATTERN
+}
+
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudwatch_event_target" "test" {
+  arn            = "${aws_sns_topic.test.arn}"
+  event_bus_name = "${aws_cloudwatch_event_rule.test.event_bus_name}"
+  rule           = "${aws_cloudwatch_event_rule.test.name}"
+  target_id      = %[1]q
+}
+`, rName)
+}
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
 
@@ -37,6 +38,15 @@ func resourceAwsCloudWatchMetricAlarm() *schema.Resource {
 			"comparison_operator": {
 				Type:     schema.TypeString,
 				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					cloudwatch.ComparisonOperatorGreaterThanOrEqualToThreshold,
+					cloudwatch.ComparisonOperatorGreaterThanThreshold,
+					cloudwatch.ComparisonOperatorLessThanThreshold,
+					cloudwatch.ComparisonOperatorLessThanOrEqualToThreshold,
+					cloudwatch.ComparisonOperatorLessThanLowerOrGreaterThanUpperThreshold,
+					cloudwatch.ComparisonOperatorLessThanLowerThreshold,
+					cloudwatch.ComparisonOperatorGreaterThanUpperThreshold,
+				}, false),
 			},
 			"evaluation_periods": {
 				Type:         schema.TypeInt,
@@ -123,8 +133,15 @@ func resourceAwsCloudWatchMetricAlarm() *schema.Resource {
 				ConflictsWith: []string{"extended_statistic", "metric_query"},
 			},
 			"threshold": {
-				Type:     schema.TypeFloat,
-				Required: true,
+				Type:          schema.TypeFloat,
+				Optional:      true,
+				ConflictsWith: []string{"threshold_metric_id"},
+			},
+			"threshold_metric_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"threshold"},
+				ValidateFunc:  validation.StringLenBetween(1, 255),
 			},
 			"actions_enabled": {
 				Type:     schema.TypeBool,
@@ -205,16 +222,48 @@ func validateResourceAwsCloudWatchMetricAlarm(d *schema.ResourceData) error {
 		return fmt.Errorf("One of `statistic` or `extended_statistic` must be set for a cloudwatch metric alarm")
 	}
 
+	thresholdMetricID := d.Get("threshold_metric_id").(string)
+	thresholdMetricIDFound := false
+
 	if v := d.Get("metric_query"); v != nil {
 		for _, v := range v.(*schema.Set).List() {
 			metricQueryResource := v.(map[string]interface{})
+			expression := ""
 			if v, ok := metricQueryResource["expression"]; ok && v.(string) != "" {
+				expression = v.(string)
 				if v := metricQueryResource["metric"]; v != nil {
 					if len(v.([]interface{})) > 0 {
 						return fmt.Errorf("No metric_query may have both `expression` and a `metric` specified")
 					}
 				}
 			}
+			if thresholdMetricID != "" && metricQueryResource["id"].(string) == thresholdMetricID {
+				if !strings.Contains(expression, "ANOMALY_DETECTION_BAND") {
+					return fmt.Errorf("The metric_query referenced by `threshold_metric_id` must have an `ANOMALY_DETECTION_BAND` expression")
+				}
+				thresholdMetricIDFound = true
+			}
+		}
+	}
+
+	if thresholdMetricID != "" && !thresholdMetricIDFound {
+		return fmt.Errorf("`threshold_metric_id` must reference the `id` of a metric_query")
+	}
+
+	if _, ok := d.GetOkExists("threshold"); !ok && thresholdMetricID == "" {
+		return fmt.Errorf("One of `threshold` or `threshold_metric_id` must be set for a cloudwatch metric alarm")
+	}
+
+	switch d.Get("comparison_operator").(string) {
+	case cloudwatch.ComparisonOperatorLessThanLowerOrGreaterThanUpperThreshold,
+		cloudwatch.ComparisonOperatorLessThanLowerThreshold,
+		cloudwatch.ComparisonOperatorGreaterThanUpperThreshold:
+		if thresholdMetricID == "" {
+			return fmt.Errorf("`threshold_metric_id` must be set for anomaly detection comparison operators")
+		}
+	default:
+		if thresholdMetricID != "" {
+			return fmt.Errorf("`threshold_metric_id` may only be set with anomaly detection comparison operators")
 		}
 	}
 
@@ -307,6 +356,7 @@ func resourceAwsCloudWatchMetricAlarmRead(d *schema.ResourceData, meta interface
 	d.Set("period", a.Period)
 	d.Set("statistic", a.Statistic)
 	d.Set("threshold", a.Threshold)
+	d.Set("threshold_metric_id", a.ThresholdMetricId)
 	d.Set("unit", a.Unit)
 	d.Set("extended_statistic", a.ExtendedStatistic)
 	d.Set("treat_missing_data", a.TreatMissingData)
@@ -321,10 +371,15 @@ func resourceAwsCloudWatchMetricAlarmRead(d *schema.ResourceData, meta interface
 
 func resourceAwsCloudWatchMetricAlarmUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cloudwatchconn
+
+	err := validateResourceAwsCloudWatchMetricAlarm(d)
+	if err != nil {
+		return err
+	}
 	params := getAwsCloudWatchPutMetricAlarmInput(d)
 
 	log.Printf("[DEBUG] Updating CloudWatch Metric Alarm: %#v", params)
-	_, err := conn.PutMetricAlarm(&params)
+	_, err = conn.PutMetricAlarm(&params)
 	if err != nil {
 		return fmt.Errorf("Updating metric alarm failed: %s", err)
 	}
@@ -368,11 +423,16 @@ func getAwsCloudWatchPutMetricAlarmInput(d *schema.ResourceData) cloudwatch.PutM
 		AlarmName:          aws.String(d.Get("alarm_name").(string)),
 		ComparisonOperator: aws.String(d.Get("comparison_operator").(string)),
 		EvaluationPeriods:  aws.Int64(int64(d.Get("evaluation_periods").(int))),
-		Threshold:          aws.Float64(d.Get("threshold").(float64)),
 		TreatMissingData:   aws.String(d.Get("treat_missing_data").(string)),
 		Tags:               tagsFromMapCloudWatch(d.Get("tags").(map[string]interface{})),
 	}
 
+	if v, ok := d.GetOk("threshold_metric_id"); ok {
+		params.ThresholdMetricId = aws.String(v.(string))
+	} else {
+		params.Threshold = aws.Float64(d.Get("threshold").(float64))
+	}
+
 	if v := d.Get("actions_enabled"); v != nil {
 		params.ActionsEnabled = aws.Bool(v.(bool))
 	}
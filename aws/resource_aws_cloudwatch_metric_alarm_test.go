@@ -286,6 +286,46 @@ func TestAccAWSCloudWatchMetricAlarm_expression(t *testing.T) {
 	})
 }
 
+func TestAccAWSCloudWatchMetricAlarm_AnomalyDetectionBand(t *testing.T) {
+	var alarm cloudwatch.MetricAlarm
+	resourceName := "aws_cloudwatch_metric_alarm.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchMetricAlarmDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSCloudWatchMetricAlarmConfigAnomalyDetectionBand(rName, "GreaterThanThreshold"),
+				ExpectError: regexp.MustCompile("`threshold_metric_id` may only be set with anomaly detection comparison operators"),
+			},
+			{
+				Config: testAccAWSCloudWatchMetricAlarmConfigAnomalyDetectionBand(rName, "LessThanLowerOrGreaterThanUpperThreshold"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchMetricAlarmExists(resourceName, &alarm),
+					resource.TestCheckResourceAttr(resourceName, "comparison_operator", "LessThanLowerOrGreaterThanUpperThreshold"),
+					resource.TestCheckResourceAttr(resourceName, "metric_query.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "threshold_metric_id", "e1"),
+				),
+			},
+			{
+				Config: testAccAWSCloudWatchMetricAlarmConfigAnomalyDetectionBand(rName, "GreaterThanUpperThreshold"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudWatchMetricAlarmExists(resourceName, &alarm),
+					resource.TestCheckResourceAttr(resourceName, "comparison_operator", "GreaterThanUpperThreshold"),
+					resource.TestCheckResourceAttr(resourceName, "threshold_metric_id", "e1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAWSCloudWatchMetricAlarm_missingStatistic(t *testing.T) {
 	rInt := acctest.RandInt()
 	resource.ParallelTest(t, resource.TestCase{
@@ -938,3 +978,39 @@ resource "aws_cloudwatch_metric_alarm" "foobar" {
 }
 `, rInt)
 }
+
+func testAccAWSCloudWatchMetricAlarmConfigAnomalyDetectionBand(rName, comparisonOperator string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_metric_alarm" "test" {
+  alarm_name          = %[1]q
+  comparison_operator = %[2]q
+  evaluation_periods  = "2"
+  threshold_metric_id = "e1"
+  alarm_description   = "This metric monitors ec2 cpu utilization"
+
+  metric_query {
+    id          = "e1"
+    expression  = "ANOMALY_DETECTION_BAND(m1)"
+    label       = "CPUUtilization (Expected)"
+    return_data = "true"
+  }
+
+  metric_query {
+    id          = "m1"
+    return_data = "true"
+
+    metric {
+      metric_name = "CPUUtilization"
+      namespace   = "AWS/EC2"
+      period      = "120"
+      stat        = "Average"
+      unit        = "Count"
+
+      dimensions = {
+        InstanceId = "i-abc123"
+      }
+    }
+  }
+}
+`, rName, comparisonOperator)
+}
@@ -0,0 +1,327 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsCloudWatchMetricAnomalyDetector() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsCloudWatchMetricAnomalyDetectorCreate,
+		Read:   resourceAwsCloudWatchMetricAnomalyDetectorRead,
+		Update: resourceAwsCloudWatchMetricAnomalyDetectorUpdate,
+		Delete: resourceAwsCloudWatchMetricAnomalyDetectorDelete,
+
+		Schema: map[string]*schema.Schema{
+			"dimensions": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"excluded_time_range": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"end_time": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.ValidateRFC3339TimeString,
+						},
+						"start_time": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.ValidateRFC3339TimeString,
+						},
+					},
+				},
+			},
+			"metric_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"metric_timezone": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 50),
+			},
+			"namespace": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"stat": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceAwsCloudWatchMetricAnomalyDetectorCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatchconn
+
+	metricName := d.Get("metric_name").(string)
+
+	input, err := getAwsCloudWatchPutAnomalyDetectorInput(d)
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating CloudWatch Metric Anomaly Detector: %s", input)
+	if _, err := conn.PutAnomalyDetector(input); err != nil {
+		return fmt.Errorf("error creating CloudWatch Metric Anomaly Detector (%s): %s", metricName, err)
+	}
+
+	d.SetId(resource.PrefixedUniqueId(fmt.Sprintf("%s-", metricName)))
+
+	return resourceAwsCloudWatchMetricAnomalyDetectorRead(d, meta)
+}
+
+func resourceAwsCloudWatchMetricAnomalyDetectorRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatchconn
+
+	anomalyDetector, err := cloudwatchDescribeAnomalyDetector(conn, d.Get("namespace").(string), d.Get("metric_name").(string), d.Get("stat").(string), expandAwsCloudWatchMetricAnomalyDetectorDimensions(d.Get("dimensions").(map[string]interface{})))
+
+	if err != nil {
+		return fmt.Errorf("error reading CloudWatch Metric Anomaly Detector (%s): %s", d.Id(), err)
+	}
+
+	if anomalyDetector == nil {
+		log.Printf("[WARN] CloudWatch Metric Anomaly Detector (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("dimensions", flattenDimensions(anomalyDetector.Dimensions)); err != nil {
+		return fmt.Errorf("error setting dimensions: %s", err)
+	}
+
+	var excludedTimeRanges []interface{}
+	var metricTimezone string
+
+	if configuration := anomalyDetector.Configuration; configuration != nil {
+		excludedTimeRanges = flattenAwsCloudWatchMetricAnomalyDetectorExcludedTimeRanges(configuration.ExcludedTimeRanges)
+		metricTimezone = aws.StringValue(configuration.MetricTimezone)
+	}
+
+	if err := d.Set("excluded_time_range", excludedTimeRanges); err != nil {
+		return fmt.Errorf("error setting excluded_time_range: %s", err)
+	}
+
+	d.Set("metric_name", anomalyDetector.MetricName)
+	d.Set("metric_timezone", metricTimezone)
+	d.Set("namespace", anomalyDetector.Namespace)
+	d.Set("stat", anomalyDetector.Stat)
+
+	return nil
+}
+
+func resourceAwsCloudWatchMetricAnomalyDetectorUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatchconn
+
+	input, err := getAwsCloudWatchPutAnomalyDetectorInput(d)
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating CloudWatch Metric Anomaly Detector (%s): %s", d.Id(), input)
+	if _, err := conn.PutAnomalyDetector(input); err != nil {
+		return fmt.Errorf("error updating CloudWatch Metric Anomaly Detector (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsCloudWatchMetricAnomalyDetectorRead(d, meta)
+}
+
+func resourceAwsCloudWatchMetricAnomalyDetectorDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cloudwatchconn
+
+	input := &cloudwatch.DeleteAnomalyDetectorInput{
+		Dimensions: expandAwsCloudWatchMetricAnomalyDetectorDimensions(d.Get("dimensions").(map[string]interface{})),
+		MetricName: aws.String(d.Get("metric_name").(string)),
+		Namespace:  aws.String(d.Get("namespace").(string)),
+		Stat:       aws.String(d.Get("stat").(string)),
+	}
+
+	log.Printf("[DEBUG] Deleting CloudWatch Metric Anomaly Detector (%s): %s", d.Id(), input)
+	_, err := conn.DeleteAnomalyDetector(input)
+
+	if isAWSErr(err, cloudwatch.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting CloudWatch Metric Anomaly Detector (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func getAwsCloudWatchPutAnomalyDetectorInput(d *schema.ResourceData) (*cloudwatch.PutAnomalyDetectorInput, error) {
+	excludedTimeRanges, err := expandAwsCloudWatchMetricAnomalyDetectorExcludedTimeRanges(d.Get("excluded_time_range").([]interface{}))
+
+	if err != nil {
+		return nil, err
+	}
+
+	configuration := &cloudwatch.AnomalyDetectorConfiguration{
+		ExcludedTimeRanges: excludedTimeRanges,
+	}
+
+	if v, ok := d.GetOk("metric_timezone"); ok {
+		configuration.MetricTimezone = aws.String(v.(string))
+	}
+
+	input := &cloudwatch.PutAnomalyDetectorInput{
+		Configuration: configuration,
+		Dimensions:    expandAwsCloudWatchMetricAnomalyDetectorDimensions(d.Get("dimensions").(map[string]interface{})),
+		MetricName:    aws.String(d.Get("metric_name").(string)),
+		Namespace:     aws.String(d.Get("namespace").(string)),
+		Stat:          aws.String(d.Get("stat").(string)),
+	}
+
+	return input, nil
+}
+
+// cloudwatchDescribeAnomalyDetector returns the anomaly detector matching the metric, statistic and
+// exact set of dimensions, as DescribeAnomalyDetectors also returns detectors with additional dimensions.
+func cloudwatchDescribeAnomalyDetector(conn *cloudwatch.CloudWatch, namespace, metricName, stat string, dimensions []*cloudwatch.Dimension) (*cloudwatch.AnomalyDetector, error) {
+	input := &cloudwatch.DescribeAnomalyDetectorsInput{
+		Dimensions: dimensions,
+		MetricName: aws.String(metricName),
+		Namespace:  aws.String(namespace),
+	}
+
+	for {
+		output, err := conn.DescribeAnomalyDetectors(input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil {
+			return nil, nil
+		}
+
+		for _, anomalyDetector := range output.AnomalyDetectors {
+			if anomalyDetector == nil {
+				continue
+			}
+
+			if aws.StringValue(anomalyDetector.Stat) != stat {
+				continue
+			}
+
+			if !cloudwatchDimensionsEqual(anomalyDetector.Dimensions, dimensions) {
+				continue
+			}
+
+			return anomalyDetector, nil
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return nil, nil
+}
+
+func cloudwatchDimensionsEqual(a, b []*cloudwatch.Dimension) bool {
+	ma := flattenDimensions(a)
+	mb := flattenDimensions(b)
+
+	if len(ma) != len(mb) {
+		return false
+	}
+
+	for k, v := range ma {
+		if w, ok := mb[k]; !ok || w != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+func expandAwsCloudWatchMetricAnomalyDetectorDimensions(m map[string]interface{}) []*cloudwatch.Dimension {
+	if len(m) == 0 {
+		return nil
+	}
+
+	dimensions := make([]*cloudwatch.Dimension, 0, len(m))
+
+	for k, v := range m {
+		dimensions = append(dimensions, &cloudwatch.Dimension{
+			Name:  aws.String(k),
+			Value: aws.String(v.(string)),
+		})
+	}
+
+	return dimensions
+}
+
+func expandAwsCloudWatchMetricAnomalyDetectorExcludedTimeRanges(l []interface{}) ([]*cloudwatch.Range, error) {
+	ranges := make([]*cloudwatch.Range, 0, len(l))
+
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		startTime, err := time.Parse(time.RFC3339, tfMap["start_time"].(string))
+
+		if err != nil {
+			return nil, fmt.Errorf("error parsing excluded_time_range start_time: %s", err)
+		}
+
+		endTime, err := time.Parse(time.RFC3339, tfMap["end_time"].(string))
+
+		if err != nil {
+			return nil, fmt.Errorf("error parsing excluded_time_range end_time: %s", err)
+		}
+
+		ranges = append(ranges, &cloudwatch.Range{
+			EndTime:   aws.Time(endTime),
+			StartTime: aws.Time(startTime),
+		})
+	}
+
+	return ranges, nil
+}
+
+func flattenAwsCloudWatchMetricAnomalyDetectorExcludedTimeRanges(ranges []*cloudwatch.Range) []interface{} {
+	l := make([]interface{}, 0, len(ranges))
+
+	for _, r := range ranges {
+		if r == nil {
+			continue
+		}
+
+		l = append(l, map[string]interface{}{
+			"end_time":   aws.TimeValue(r.EndTime).Format(time.RFC3339),
+			"start_time": aws.TimeValue(r.StartTime).Format(time.RFC3339),
+		})
+	}
+
+	return l
+}
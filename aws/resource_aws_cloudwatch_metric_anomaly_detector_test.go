@@ -0,0 +1,215 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSCloudWatchMetricAnomalyDetector_basic(t *testing.T) {
+	var anomalyDetector cloudwatch.AnomalyDetector
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudwatch_metric_anomaly_detector.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchMetricAnomalyDetectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchMetricAnomalyDetectorConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudWatchMetricAnomalyDetectorExists(resourceName, &anomalyDetector),
+					resource.TestCheckResourceAttr(resourceName, "dimensions.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dimensions.InstanceId", rName),
+					resource.TestCheckResourceAttr(resourceName, "excluded_time_range.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "metric_name", "CPUUtilization"),
+					resource.TestCheckResourceAttr(resourceName, "metric_timezone", ""),
+					resource.TestCheckResourceAttr(resourceName, "namespace", "AWS/EC2"),
+					resource.TestCheckResourceAttr(resourceName, "stat", "Average"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudWatchMetricAnomalyDetector_disappears(t *testing.T) {
+	var anomalyDetector cloudwatch.AnomalyDetector
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudwatch_metric_anomaly_detector.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchMetricAnomalyDetectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchMetricAnomalyDetectorConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudWatchMetricAnomalyDetectorExists(resourceName, &anomalyDetector),
+					testAccCheckAWSCloudWatchMetricAnomalyDetectorDisappears(&anomalyDetector),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSCloudWatchMetricAnomalyDetector_Configuration(t *testing.T) {
+	var anomalyDetector cloudwatch.AnomalyDetector
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cloudwatch_metric_anomaly_detector.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCloudWatchMetricAnomalyDetectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCloudWatchMetricAnomalyDetectorConfigConfiguration(rName, "2019-07-01T00:00:00Z", "2019-07-02T00:00:00Z", "Europe/London"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudWatchMetricAnomalyDetectorExists(resourceName, &anomalyDetector),
+					resource.TestCheckResourceAttr(resourceName, "excluded_time_range.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "excluded_time_range.0.start_time", "2019-07-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "excluded_time_range.0.end_time", "2019-07-02T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "metric_timezone", "Europe/London"),
+				),
+			},
+			{
+				Config: testAccAWSCloudWatchMetricAnomalyDetectorConfigConfiguration(rName, "2019-08-01T00:00:00Z", "2019-08-03T00:00:00Z", "UTC"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudWatchMetricAnomalyDetectorExists(resourceName, &anomalyDetector),
+					resource.TestCheckResourceAttr(resourceName, "excluded_time_range.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "excluded_time_range.0.start_time", "2019-08-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "excluded_time_range.0.end_time", "2019-08-03T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "metric_timezone", "UTC"),
+				),
+			},
+			{
+				Config: testAccAWSCloudWatchMetricAnomalyDetectorConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCloudWatchMetricAnomalyDetectorExists(resourceName, &anomalyDetector),
+					resource.TestCheckResourceAttr(resourceName, "excluded_time_range.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSCloudWatchMetricAnomalyDetectorExists(resourceName string, anomalyDetector *cloudwatch.AnomalyDetector) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).cloudwatchconn
+
+		output, err := testAccAWSCloudWatchMetricAnomalyDetectorDescribe(conn, rs)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("CloudWatch Metric Anomaly Detector (%s) not found", rs.Primary.ID)
+		}
+
+		*anomalyDetector = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSCloudWatchMetricAnomalyDetectorDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).cloudwatchconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_cloudwatch_metric_anomaly_detector" {
+			continue
+		}
+
+		output, err := testAccAWSCloudWatchMetricAnomalyDetectorDescribe(conn, rs)
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil {
+			return fmt.Errorf("CloudWatch Metric Anomaly Detector (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSCloudWatchMetricAnomalyDetectorDisappears(anomalyDetector *cloudwatch.AnomalyDetector) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).cloudwatchconn
+
+		_, err := conn.DeleteAnomalyDetector(&cloudwatch.DeleteAnomalyDetectorInput{
+			Dimensions: anomalyDetector.Dimensions,
+			MetricName: anomalyDetector.MetricName,
+			Namespace:  anomalyDetector.Namespace,
+			Stat:       anomalyDetector.Stat,
+		})
+
+		return err
+	}
+}
+
+func testAccAWSCloudWatchMetricAnomalyDetectorDescribe(conn *cloudwatch.CloudWatch, rs *terraform.ResourceState) (*cloudwatch.AnomalyDetector, error) {
+	var dimensions []*cloudwatch.Dimension
+
+	if v := rs.Primary.Attributes["dimensions.InstanceId"]; v != "" {
+		dimensions = append(dimensions, &cloudwatch.Dimension{
+			Name:  aws.String("InstanceId"),
+			Value: aws.String(v),
+		})
+	}
+
+	return cloudwatchDescribeAnomalyDetector(conn, rs.Primary.Attributes["namespace"], rs.Primary.Attributes["metric_name"], rs.Primary.Attributes["stat"], dimensions)
+}
+
+func testAccAWSCloudWatchMetricAnomalyDetectorConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_metric_anomaly_detector" "test" {
+  metric_name = "CPUUtilization"
+  namespace   = "AWS/EC2"
+  stat        = "Average"
+
+  dimensions = {
+    InstanceId = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccAWSCloudWatchMetricAnomalyDetectorConfigConfiguration(rName, startTime, endTime, metricTimezone string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_metric_anomaly_detector" "test" {
+  metric_name     = "CPUUtilization"
+  metric_timezone = %[4]q
+  namespace       = "AWS/EC2"
+  stat            = "Average"
+
+  dimensions = {
+    InstanceId = %[1]q
+  }
+
+  excluded_time_range {
+    start_time = %[2]q
+    end_time   = %[3]q
+  }
+}
+`, rName, startTime, endTime, metricTimezone)
+}
@@ -0,0 +1,205 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsEc2ClientVpnAuthorizationRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEc2ClientVpnAuthorizationRuleCreate,
+		Read:   resourceAwsEc2ClientVpnAuthorizationRuleRead,
+		Delete: resourceAwsEc2ClientVpnAuthorizationRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsEc2ClientVpnAuthorizationRuleImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_group_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"authorize_all_groups"},
+			},
+			"authorize_all_groups": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"access_group_id"},
+			},
+			"client_vpn_endpoint_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"target_network_cidr": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCIDRNetworkAddress,
+			},
+		},
+	}
+}
+
+func resourceAwsEc2ClientVpnAuthorizationRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	clientVpnEndpointID := d.Get("client_vpn_endpoint_id").(string)
+	targetNetworkCidr := d.Get("target_network_cidr").(string)
+	accessGroupID := d.Get("access_group_id").(string)
+	authorizeAllGroups := d.Get("authorize_all_groups").(bool)
+
+	if accessGroupID == "" && !authorizeAllGroups {
+		return fmt.Errorf("one of access_group_id or authorize_all_groups must be configured")
+	}
+
+	input := &ec2.AuthorizeClientVpnIngressInput{
+		ClientVpnEndpointId: aws.String(clientVpnEndpointID),
+		TargetNetworkCidr:   aws.String(targetNetworkCidr),
+	}
+
+	if accessGroupID != "" {
+		input.AccessGroupId = aws.String(accessGroupID)
+	}
+
+	if authorizeAllGroups {
+		input.AuthorizeAllGroups = aws.Bool(true)
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Client VPN Authorization Rule: %s", input)
+	if _, err := conn.AuthorizeClientVpnIngress(input); err != nil {
+		return fmt.Errorf("error creating EC2 Client VPN Endpoint (%s) Authorization Rule (%s): %s", clientVpnEndpointID, targetNetworkCidr, err)
+	}
+
+	parts := []string{clientVpnEndpointID, targetNetworkCidr}
+	if accessGroupID != "" {
+		parts = append(parts, accessGroupID)
+	}
+	d.SetId(strings.Join(parts, "_"))
+
+	if err := waitForEc2ClientVpnAuthorizationRuleAuthorization(conn, clientVpnEndpointID, targetNetworkCidr, accessGroupID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for EC2 Client VPN Authorization Rule (%s) authorization: %s", d.Id(), err)
+	}
+
+	return resourceAwsEc2ClientVpnAuthorizationRuleRead(d, meta)
+}
+
+func resourceAwsEc2ClientVpnAuthorizationRuleRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	clientVpnEndpointID, targetNetworkCidr, accessGroupID, err := decodeEc2ClientVpnAuthorizationRuleID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	rule, err := ec2DescribeClientVpnAuthorizationRule(conn, clientVpnEndpointID, targetNetworkCidr, accessGroupID)
+
+	if isAWSErr(err, "InvalidClientVpnEndpointId.NotFound", "") {
+		log.Printf("[WARN] EC2 Client VPN Endpoint (%s) not found, removing Authorization Rule (%s) from state", clientVpnEndpointID, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Client VPN Authorization Rule (%s): %s", d.Id(), err)
+	}
+
+	if rule == nil {
+		log.Printf("[WARN] EC2 Client VPN Authorization Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if rule.Status != nil && aws.StringValue(rule.Status.Code) == ec2.ClientVpnAuthorizationRuleStatusCodeRevoking {
+		log.Printf("[WARN] EC2 Client VPN Authorization Rule (%s) is being revoked, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("access_group_id", rule.GroupId)
+	d.Set("authorize_all_groups", aws.BoolValue(rule.AccessAll))
+	d.Set("client_vpn_endpoint_id", rule.ClientVpnEndpointId)
+	d.Set("description", rule.Description)
+
+	if rule.Status != nil {
+		d.Set("status", rule.Status.Code)
+	}
+
+	d.Set("target_network_cidr", rule.DestinationCidr)
+
+	return nil
+}
+
+func resourceAwsEc2ClientVpnAuthorizationRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	clientVpnEndpointID, targetNetworkCidr, accessGroupID, err := decodeEc2ClientVpnAuthorizationRuleID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &ec2.RevokeClientVpnIngressInput{
+		ClientVpnEndpointId: aws.String(clientVpnEndpointID),
+		TargetNetworkCidr:   aws.String(targetNetworkCidr),
+	}
+
+	if accessGroupID != "" {
+		input.AccessGroupId = aws.String(accessGroupID)
+	} else {
+		input.RevokeAllGroups = aws.Bool(true)
+	}
+
+	log.Printf("[DEBUG] Deleting EC2 Client VPN Authorization Rule (%s): %s", d.Id(), input)
+	_, err = conn.RevokeClientVpnIngress(input)
+
+	if isAWSErr(err, "InvalidClientVpnEndpointId.NotFound", "") || isAWSErr(err, "InvalidClientVpnEndpointAuthorizationRuleNotFound", "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 Client VPN Authorization Rule (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForEc2ClientVpnAuthorizationRuleRevocation(conn, clientVpnEndpointID, targetNetworkCidr, accessGroupID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for EC2 Client VPN Authorization Rule (%s) revocation: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsEc2ClientVpnAuthorizationRuleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, _, _, err := decodeEc2ClientVpnAuthorizationRuleID(d.Id()); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
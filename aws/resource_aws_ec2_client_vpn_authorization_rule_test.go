@@ -0,0 +1,172 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAwsEc2ClientVpnAuthorizationRule_basic(t *testing.T) {
+	var rule1 ec2.AuthorizationRule
+	resourceName := "aws_ec2_client_vpn_authorization_rule.test"
+	endpointResourceName := "aws_ec2_client_vpn_endpoint.test"
+	rStr := acctest.RandString(5)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProvidersWithTLS,
+		CheckDestroy: testAccCheckAwsEc2ClientVpnAuthorizationRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEc2ClientVpnAuthorizationRuleConfigAllGroups(rStr),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsEc2ClientVpnAuthorizationRuleExists(resourceName, &rule1),
+					resource.TestCheckResourceAttr(resourceName, "access_group_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "authorize_all_groups", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "client_vpn_endpoint_id", endpointResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "description", rStr),
+					resource.TestCheckResourceAttr(resourceName, "status", ec2.ClientVpnAuthorizationRuleStatusCodeActive),
+					resource.TestCheckResourceAttr(resourceName, "target_network_cidr", "10.1.1.0/24"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAwsEc2ClientVpnAuthorizationRule_disappears(t *testing.T) {
+	var rule1 ec2.AuthorizationRule
+	resourceName := "aws_ec2_client_vpn_authorization_rule.test"
+	rStr := acctest.RandString(5)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProvidersWithTLS,
+		CheckDestroy: testAccCheckAwsEc2ClientVpnAuthorizationRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEc2ClientVpnAuthorizationRuleConfigAllGroups(rStr),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsEc2ClientVpnAuthorizationRuleExists(resourceName, &rule1),
+					testAccCheckAwsEc2ClientVpnAuthorizationRuleDisappears(&rule1),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsEc2ClientVpnAuthorizationRuleDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_client_vpn_authorization_rule" {
+			continue
+		}
+
+		clientVpnEndpointID, targetNetworkCidr, accessGroupID, err := decodeEc2ClientVpnAuthorizationRuleID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		rule, err := ec2DescribeClientVpnAuthorizationRule(conn, clientVpnEndpointID, targetNetworkCidr, accessGroupID)
+
+		if isAWSErr(err, "InvalidClientVpnEndpointId.NotFound", "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if rule != nil {
+			return fmt.Errorf("EC2 Client VPN Authorization Rule (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsEc2ClientVpnAuthorizationRuleDisappears(rule *ec2.AuthorizationRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		clientVpnEndpointID := aws.StringValue(rule.ClientVpnEndpointId)
+		targetNetworkCidr := aws.StringValue(rule.DestinationCidr)
+		accessGroupID := aws.StringValue(rule.GroupId)
+
+		input := &ec2.RevokeClientVpnIngressInput{
+			ClientVpnEndpointId: aws.String(clientVpnEndpointID),
+			TargetNetworkCidr:   aws.String(targetNetworkCidr),
+		}
+
+		if accessGroupID != "" {
+			input.AccessGroupId = aws.String(accessGroupID)
+		} else {
+			input.RevokeAllGroups = aws.Bool(true)
+		}
+
+		if _, err := conn.RevokeClientVpnIngress(input); err != nil {
+			return err
+		}
+
+		return waitForEc2ClientVpnAuthorizationRuleRevocation(conn, clientVpnEndpointID, targetNetworkCidr, accessGroupID, 10*time.Minute)
+	}
+}
+
+func testAccCheckAwsEc2ClientVpnAuthorizationRuleExists(resourceName string, rule *ec2.AuthorizationRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Client VPN Authorization Rule ID is set")
+		}
+
+		clientVpnEndpointID, targetNetworkCidr, accessGroupID, err := decodeEc2ClientVpnAuthorizationRuleID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		output, err := ec2DescribeClientVpnAuthorizationRule(conn, clientVpnEndpointID, targetNetworkCidr, accessGroupID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("EC2 Client VPN Authorization Rule (%s) not found", rs.Primary.ID)
+		}
+
+		*rule = *output
+
+		return nil
+	}
+}
+
+func testAccEc2ClientVpnAuthorizationRuleConfigAllGroups(rName string) string {
+	return testAccEc2ClientVpnNetworkAssociationConfig(rName) + fmt.Sprintf(`
+resource "aws_ec2_client_vpn_authorization_rule" "test" {
+  authorize_all_groups   = true
+  client_vpn_endpoint_id = "${aws_ec2_client_vpn_endpoint.test.id}"
+  description            = %[1]q
+  target_network_cidr    = "${aws_subnet.test.cidr_block}"
+}
+`, rName)
+}
@@ -15,6 +15,9 @@ func resourceAwsEc2ClientVpnNetworkAssociation() *schema.Resource {
 		Create: resourceAwsEc2ClientVpnNetworkAssociationCreate,
 		Read:   resourceAwsEc2ClientVpnNetworkAssociationRead,
 		Delete: resourceAwsEc2ClientVpnNetworkAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsEc2ClientVpnNetworkAssociationImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"client_vpn_endpoint_id": {
@@ -151,6 +154,19 @@ func resourceAwsEc2ClientVpnNetworkAssociationDelete(d *schema.ResourceData, met
 	return nil
 }
 
+func resourceAwsEc2ClientVpnNetworkAssociationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	clientVpnEndpointID, associationID, err := decodeEc2ClientVpnNetworkAssociationID(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(associationID)
+	d.Set("client_vpn_endpoint_id", clientVpnEndpointID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func clientVpnNetworkAssociationRefreshFunc(conn *ec2.EC2, cvnaID string, cvepID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		resp, err := conn.DescribeClientVpnTargetNetworks(&ec2.DescribeClientVpnTargetNetworksInput{
@@ -0,0 +1,187 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsEc2ClientVpnRoute() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEc2ClientVpnRouteCreate,
+		Read:   resourceAwsEc2ClientVpnRouteRead,
+		Delete: resourceAwsEc2ClientVpnRouteDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsEc2ClientVpnRouteImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"client_vpn_endpoint_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"destination_cidr_block": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCIDRNetworkAddress,
+			},
+			"origin": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"target_vpc_subnet_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsEc2ClientVpnRouteCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	clientVpnEndpointID := d.Get("client_vpn_endpoint_id").(string)
+	targetSubnetID := d.Get("target_vpc_subnet_id").(string)
+	destinationCidr := d.Get("destination_cidr_block").(string)
+
+	input := &ec2.CreateClientVpnRouteInput{
+		ClientVpnEndpointId:  aws.String(clientVpnEndpointID),
+		DestinationCidrBlock: aws.String(destinationCidr),
+		TargetVpcSubnetId:    aws.String(targetSubnetID),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Client VPN Route: %s", input)
+	if _, err := conn.CreateClientVpnRoute(input); err != nil {
+		return fmt.Errorf("error creating EC2 Client VPN Endpoint (%s) Route (%s): %s", clientVpnEndpointID, destinationCidr, err)
+	}
+
+	d.SetId(strings.Join([]string{clientVpnEndpointID, targetSubnetID, destinationCidr}, "_"))
+
+	if err := waitForEc2ClientVpnRouteCreation(conn, clientVpnEndpointID, targetSubnetID, destinationCidr, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for EC2 Client VPN Route (%s) creation: %s", d.Id(), err)
+	}
+
+	return resourceAwsEc2ClientVpnRouteRead(d, meta)
+}
+
+func resourceAwsEc2ClientVpnRouteRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	clientVpnEndpointID, targetSubnetID, destinationCidr, err := decodeEc2ClientVpnRouteID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	route, err := ec2DescribeClientVpnRoute(conn, clientVpnEndpointID, targetSubnetID, destinationCidr)
+
+	if isAWSErr(err, "InvalidClientVpnEndpointId.NotFound", "") {
+		log.Printf("[WARN] EC2 Client VPN Endpoint (%s) not found, removing Route (%s) from state", clientVpnEndpointID, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Client VPN Route (%s): %s", d.Id(), err)
+	}
+
+	if route == nil {
+		log.Printf("[WARN] EC2 Client VPN Route (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if route.Status != nil && aws.StringValue(route.Status.Code) == ec2.ClientVpnRouteStatusCodeDeleting {
+		log.Printf("[WARN] EC2 Client VPN Route (%s) is being deleted, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("client_vpn_endpoint_id", route.ClientVpnEndpointId)
+	d.Set("description", route.Description)
+	d.Set("destination_cidr_block", route.DestinationCidr)
+	d.Set("origin", route.Origin)
+
+	if route.Status != nil {
+		d.Set("status", route.Status.Code)
+	}
+
+	d.Set("target_vpc_subnet_id", route.TargetSubnet)
+	d.Set("type", route.Type)
+
+	return nil
+}
+
+func resourceAwsEc2ClientVpnRouteDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	clientVpnEndpointID, targetSubnetID, destinationCidr, err := decodeEc2ClientVpnRouteID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &ec2.DeleteClientVpnRouteInput{
+		ClientVpnEndpointId:  aws.String(clientVpnEndpointID),
+		DestinationCidrBlock: aws.String(destinationCidr),
+		TargetVpcSubnetId:    aws.String(targetSubnetID),
+	}
+
+	log.Printf("[DEBUG] Deleting EC2 Client VPN Route (%s): %s", d.Id(), input)
+	_, err = conn.DeleteClientVpnRoute(input)
+
+	if isAWSErr(err, "InvalidClientVpnEndpointId.NotFound", "") || isAWSErr(err, "InvalidClientVpnRouteNotFound", "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 Client VPN Route (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForEc2ClientVpnRouteDeletion(conn, clientVpnEndpointID, targetSubnetID, destinationCidr, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for EC2 Client VPN Route (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsEc2ClientVpnRouteImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, _, _, err := decodeEc2ClientVpnRouteID(d.Id()); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
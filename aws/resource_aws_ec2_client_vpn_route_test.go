@@ -0,0 +1,165 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAwsEc2ClientVpnRoute_basic(t *testing.T) {
+	var route1 ec2.ClientVpnRoute
+	resourceName := "aws_ec2_client_vpn_route.test"
+	endpointResourceName := "aws_ec2_client_vpn_endpoint.test"
+	subnetResourceName := "aws_subnet.test"
+	rStr := acctest.RandString(5)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProvidersWithTLS,
+		CheckDestroy: testAccCheckAwsEc2ClientVpnRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEc2ClientVpnRouteConfig(rStr),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsEc2ClientVpnRouteExists(resourceName, &route1),
+					resource.TestCheckResourceAttrPair(resourceName, "client_vpn_endpoint_id", endpointResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "description", rStr),
+					resource.TestCheckResourceAttr(resourceName, "destination_cidr_block", "192.168.0.0/24"),
+					resource.TestCheckResourceAttr(resourceName, "origin", "add-route"),
+					resource.TestCheckResourceAttr(resourceName, "status", ec2.ClientVpnRouteStatusCodeActive),
+					resource.TestCheckResourceAttrPair(resourceName, "target_vpc_subnet_id", subnetResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "type", "Nat"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAwsEc2ClientVpnRoute_disappears(t *testing.T) {
+	var route1 ec2.ClientVpnRoute
+	resourceName := "aws_ec2_client_vpn_route.test"
+	rStr := acctest.RandString(5)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProvidersWithTLS,
+		CheckDestroy: testAccCheckAwsEc2ClientVpnRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEc2ClientVpnRouteConfig(rStr),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsEc2ClientVpnRouteExists(resourceName, &route1),
+					testAccCheckAwsEc2ClientVpnRouteDisappears(&route1),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsEc2ClientVpnRouteDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_client_vpn_route" {
+			continue
+		}
+
+		clientVpnEndpointID, targetSubnetID, destinationCidr, err := decodeEc2ClientVpnRouteID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		route, err := ec2DescribeClientVpnRoute(conn, clientVpnEndpointID, targetSubnetID, destinationCidr)
+
+		if isAWSErr(err, "InvalidClientVpnEndpointId.NotFound", "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if route != nil {
+			return fmt.Errorf("EC2 Client VPN Route (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsEc2ClientVpnRouteDisappears(route *ec2.ClientVpnRoute) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		input := &ec2.DeleteClientVpnRouteInput{
+			ClientVpnEndpointId:  route.ClientVpnEndpointId,
+			DestinationCidrBlock: route.DestinationCidr,
+			TargetVpcSubnetId:    route.TargetSubnet,
+		}
+
+		if _, err := conn.DeleteClientVpnRoute(input); err != nil {
+			return err
+		}
+
+		return waitForEc2ClientVpnRouteDeletion(conn, aws.StringValue(route.ClientVpnEndpointId), aws.StringValue(route.TargetSubnet), aws.StringValue(route.DestinationCidr), 10*time.Minute)
+	}
+}
+
+func testAccCheckAwsEc2ClientVpnRouteExists(resourceName string, route *ec2.ClientVpnRoute) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Client VPN Route ID is set")
+		}
+
+		clientVpnEndpointID, targetSubnetID, destinationCidr, err := decodeEc2ClientVpnRouteID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		output, err := ec2DescribeClientVpnRoute(conn, clientVpnEndpointID, targetSubnetID, destinationCidr)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("EC2 Client VPN Route (%s) not found", rs.Primary.ID)
+		}
+
+		*route = *output
+
+		return nil
+	}
+}
+
+func testAccEc2ClientVpnRouteConfig(rName string) string {
+	return testAccEc2ClientVpnNetworkAssociationConfig(rName) + fmt.Sprintf(`
+resource "aws_ec2_client_vpn_route" "test" {
+  client_vpn_endpoint_id = "${aws_ec2_client_vpn_endpoint.test.id}"
+  description            = %[1]q
+  destination_cidr_block = "192.168.0.0/24"
+  target_vpc_subnet_id   = "${aws_ec2_client_vpn_network_association.test.subnet_id}"
+}
+`, rName)
+}
@@ -0,0 +1,221 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsEc2Host() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEc2HostCreate,
+		Read:   resourceAwsEc2HostRead,
+		Update: resourceAwsEc2HostUpdate,
+		Delete: resourceAwsEc2HostDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auto_placement": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  ec2.AutoPlacementOn,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.AutoPlacementOff,
+					ec2.AutoPlacementOn,
+				}, false),
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"host_recovery": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  ec2.HostRecoveryOff,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.HostRecoveryOff,
+					ec2.HostRecoveryOn,
+				}, false),
+			},
+			"instance_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsEc2HostCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	input := &ec2.AllocateHostsInput{
+		AutoPlacement:     aws.String(d.Get("auto_placement").(string)),
+		AvailabilityZone:  aws.String(d.Get("availability_zone").(string)),
+		HostRecovery:      aws.String(d.Get("host_recovery").(string)),
+		InstanceType:      aws.String(d.Get("instance_type").(string)),
+		Quantity:          aws.Int64(1),
+		TagSpecifications: expandEc2HostTagSpecifications(d.Get("tags").(map[string]interface{})),
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Host: %s", input)
+	output, err := conn.AllocateHosts(input)
+
+	if err != nil {
+		return fmt.Errorf("error allocating EC2 Host: %s", err)
+	}
+
+	if output == nil || len(output.HostIds) == 0 {
+		return fmt.Errorf("error allocating EC2 Host: empty response")
+	}
+
+	d.SetId(aws.StringValue(output.HostIds[0]))
+
+	if err := waitForEc2HostAvailability(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for EC2 Host (%s) availability: %s", d.Id(), err)
+	}
+
+	return resourceAwsEc2HostRead(d, meta)
+}
+
+func resourceAwsEc2HostRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	host, err := ec2DescribeHost(conn, d.Id())
+
+	if isAWSErr(err, "InvalidHostID.NotFound", "") {
+		log.Printf("[WARN] EC2 Host (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Host (%s): %s", d.Id(), err)
+	}
+
+	if host == nil {
+		log.Printf("[WARN] EC2 Host (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if state := aws.StringValue(host.State); state == ec2.AllocationStateReleased || state == ec2.AllocationStateReleasedPermanentFailure {
+		log.Printf("[WARN] EC2 Host (%s) in released state (%s), removing from state", d.Id(), state)
+		d.SetId("")
+		return nil
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "ec2",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("dedicated-host/%s", d.Id()),
+	}.String()
+
+	d.Set("arn", arn)
+	d.Set("auto_placement", host.AutoPlacement)
+	d.Set("availability_zone", host.AvailabilityZone)
+	d.Set("host_recovery", host.HostRecovery)
+
+	if host.HostProperties != nil {
+		d.Set("instance_type", host.HostProperties.InstanceType)
+	}
+
+	if err := d.Set("tags", tagsToMap(host.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsEc2HostUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	if d.HasChange("auto_placement") || d.HasChange("host_recovery") {
+		input := &ec2.ModifyHostsInput{
+			HostIds: aws.StringSlice([]string{d.Id()}),
+		}
+
+		if d.HasChange("auto_placement") {
+			input.AutoPlacement = aws.String(d.Get("auto_placement").(string))
+		}
+
+		if d.HasChange("host_recovery") {
+			input.HostRecovery = aws.String(d.Get("host_recovery").(string))
+		}
+
+		log.Printf("[DEBUG] Modifying EC2 Host (%s): %s", d.Id(), input)
+		output, err := conn.ModifyHosts(input)
+
+		if err != nil {
+			return fmt.Errorf("error modifying EC2 Host (%s): %s", d.Id(), err)
+		}
+
+		if err := ec2HostUnsuccessfulItemsError(output.Unsuccessful); err != nil {
+			return fmt.Errorf("error modifying EC2 Host (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		if err := setTags(conn, d); err != nil {
+			return fmt.Errorf("error updating EC2 Host (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsEc2HostRead(d, meta)
+}
+
+func resourceAwsEc2HostDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	input := &ec2.ReleaseHostsInput{
+		HostIds: aws.StringSlice([]string{d.Id()}),
+	}
+
+	log.Printf("[DEBUG] Releasing EC2 Host (%s): %s", d.Id(), input)
+	output, err := conn.ReleaseHosts(input)
+
+	if isAWSErr(err, "InvalidHostID.NotFound", "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error releasing EC2 Host (%s): %s", d.Id(), err)
+	}
+
+	if err := ec2HostUnsuccessfulItemsError(output.Unsuccessful); err != nil {
+		return fmt.Errorf("error releasing EC2 Host (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForEc2HostRelease(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for EC2 Host (%s) release: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func ec2HostUnsuccessfulItemsError(items []*ec2.UnsuccessfulItem) error {
+	for _, item := range items {
+		if item == nil || item.Error == nil {
+			continue
+		}
+
+		return fmt.Errorf("%s: %s", aws.StringValue(item.Error.Code), aws.StringValue(item.Error.Message))
+	}
+
+	return nil
+}
@@ -0,0 +1,289 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSEc2Host_basic(t *testing.T) {
+	var host ec2.Host
+	resourceName := "aws_ec2_host.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2HostDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2HostConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2HostExists(resourceName, &host),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "ec2", regexp.MustCompile(`dedicated-host/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "auto_placement", "on"),
+					resource.TestCheckResourceAttrPair(resourceName, "availability_zone", "data.aws_availability_zones.available", "names.0"),
+					resource.TestCheckResourceAttr(resourceName, "host_recovery", "off"),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "a1.large"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2Host_disappears(t *testing.T) {
+	var host ec2.Host
+	resourceName := "aws_ec2_host.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2HostDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2HostConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2HostExists(resourceName, &host),
+					testAccCheckAWSEc2HostDisappears(&host),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2Host_AutoPlacementAndHostRecovery(t *testing.T) {
+	var host1, host2 ec2.Host
+	resourceName := "aws_ec2_host.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2HostDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2HostConfigAutoPlacementAndHostRecovery("off", "on"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2HostExists(resourceName, &host1),
+					resource.TestCheckResourceAttr(resourceName, "auto_placement", "off"),
+					resource.TestCheckResourceAttr(resourceName, "host_recovery", "on"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSEc2HostConfigAutoPlacementAndHostRecovery("on", "off"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2HostExists(resourceName, &host2),
+					testAccCheckAWSEc2HostNotRecreated(&host1, &host2),
+					resource.TestCheckResourceAttr(resourceName, "auto_placement", "on"),
+					resource.TestCheckResourceAttr(resourceName, "host_recovery", "off"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2Host_Tags(t *testing.T) {
+	var host1, host2, host3 ec2.Host
+	resourceName := "aws_ec2_host.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2HostDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2HostConfigTags1("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2HostExists(resourceName, &host1),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSEc2HostConfigTags2("key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2HostExists(resourceName, &host2),
+					testAccCheckAWSEc2HostNotRecreated(&host1, &host2),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSEc2HostConfigTags1("key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2HostExists(resourceName, &host3),
+					testAccCheckAWSEc2HostNotRecreated(&host2, &host3),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEc2HostExists(resourceName string, host *ec2.Host) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Host ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		output, err := ec2DescribeHost(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("EC2 Host (%s) not found", rs.Primary.ID)
+		}
+
+		if state := aws.StringValue(output.State); state != ec2.AllocationStateAvailable {
+			return fmt.Errorf("EC2 Host (%s) exists in non-available (%s) state", rs.Primary.ID, state)
+		}
+
+		*host = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSEc2HostDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_host" {
+			continue
+		}
+
+		host, err := ec2DescribeHost(conn, rs.Primary.ID)
+
+		if isAWSErr(err, "InvalidHostID.NotFound", "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if host == nil {
+			continue
+		}
+
+		if state := aws.StringValue(host.State); state != ec2.AllocationStateReleased && state != ec2.AllocationStateReleasedPermanentFailure {
+			return fmt.Errorf("EC2 Host (%s) still exists in non-released (%s) state", rs.Primary.ID, state)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSEc2HostDisappears(host *ec2.Host) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		input := &ec2.ReleaseHostsInput{
+			HostIds: []*string{host.HostId},
+		}
+
+		_, err := conn.ReleaseHosts(input)
+
+		if err != nil {
+			return err
+		}
+
+		return waitForEc2HostRelease(conn, aws.StringValue(host.HostId))
+	}
+}
+
+func testAccCheckAWSEc2HostNotRecreated(i, j *ec2.Host) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(i.HostId) != aws.StringValue(j.HostId) {
+			return fmt.Errorf("EC2 Host was recreated")
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSEc2HostConfig() string {
+	return `
+data "aws_availability_zones" "available" {}
+
+resource "aws_ec2_host" "test" {
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+  instance_type     = "a1.large"
+}
+`
+}
+
+func testAccAWSEc2HostConfigAutoPlacementAndHostRecovery(autoPlacement, hostRecovery string) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {}
+
+resource "aws_ec2_host" "test" {
+  auto_placement    = %[1]q
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+  host_recovery     = %[2]q
+  instance_type     = "a1.large"
+}
+`, autoPlacement, hostRecovery)
+}
+
+func testAccAWSEc2HostConfigTags1(tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {}
+
+resource "aws_ec2_host" "test" {
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+  instance_type     = "a1.large"
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1)
+}
+
+func testAccAWSEc2HostConfigTags2(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {}
+
+resource "aws_ec2_host" "test" {
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+  instance_type     = "a1.large"
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2)
+}
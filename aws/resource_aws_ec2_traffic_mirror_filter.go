@@ -0,0 +1,165 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsEc2TrafficMirrorFilter() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEc2TrafficMirrorFilterCreate,
+		Read:   resourceAwsEc2TrafficMirrorFilterRead,
+		Update: resourceAwsEc2TrafficMirrorFilterUpdate,
+		Delete: resourceAwsEc2TrafficMirrorFilterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"network_services": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						ec2.TrafficMirrorNetworkServiceAmazonDns,
+					}, false),
+				},
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsEc2TrafficMirrorFilterCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	input := &ec2.CreateTrafficMirrorFilterInput{
+		TagSpecifications: expandEc2TrafficMirrorFilterTagSpecifications(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Traffic Mirror Filter: %s", input)
+	output, err := conn.CreateTrafficMirrorFilter(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating EC2 Traffic Mirror Filter: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.TrafficMirrorFilter.TrafficMirrorFilterId))
+
+	if v, ok := d.GetOk("network_services"); ok && v.(*schema.Set).Len() > 0 {
+		input := &ec2.ModifyTrafficMirrorFilterNetworkServicesInput{
+			AddNetworkServices:    expandStringSet(v.(*schema.Set)),
+			TrafficMirrorFilterId: aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Adding EC2 Traffic Mirror Filter (%s) network services: %s", d.Id(), input)
+		if _, err := conn.ModifyTrafficMirrorFilterNetworkServices(input); err != nil {
+			return fmt.Errorf("error adding EC2 Traffic Mirror Filter (%s) network services: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsEc2TrafficMirrorFilterRead(d, meta)
+}
+
+func resourceAwsEc2TrafficMirrorFilterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	trafficMirrorFilter, err := ec2DescribeTrafficMirrorFilter(conn, d.Id())
+
+	if isAWSErr(err, "InvalidTrafficMirrorFilterId.NotFound", "") {
+		log.Printf("[WARN] EC2 Traffic Mirror Filter (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Traffic Mirror Filter (%s): %s", d.Id(), err)
+	}
+
+	if trafficMirrorFilter == nil {
+		log.Printf("[WARN] EC2 Traffic Mirror Filter (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("description", trafficMirrorFilter.Description)
+
+	if err := d.Set("network_services", aws.StringValueSlice(trafficMirrorFilter.NetworkServices)); err != nil {
+		return fmt.Errorf("error setting network_services: %s", err)
+	}
+
+	if err := d.Set("tags", tagsToMap(trafficMirrorFilter.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsEc2TrafficMirrorFilterUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	if d.HasChange("network_services") {
+		o, n := d.GetChange("network_services")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		input := &ec2.ModifyTrafficMirrorFilterNetworkServicesInput{
+			TrafficMirrorFilterId: aws.String(d.Id()),
+		}
+
+		if add := ns.Difference(os); add.Len() > 0 {
+			input.AddNetworkServices = expandStringSet(add)
+		}
+
+		if remove := os.Difference(ns); remove.Len() > 0 {
+			input.RemoveNetworkServices = expandStringSet(remove)
+		}
+
+		log.Printf("[DEBUG] Updating EC2 Traffic Mirror Filter (%s) network services: %s", d.Id(), input)
+		if _, err := conn.ModifyTrafficMirrorFilterNetworkServices(input); err != nil {
+			return fmt.Errorf("error updating EC2 Traffic Mirror Filter (%s) network services: %s", d.Id(), err)
+		}
+	}
+
+	if err := setTags(conn, d); err != nil {
+		return fmt.Errorf("error updating EC2 Traffic Mirror Filter (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsEc2TrafficMirrorFilterRead(d, meta)
+}
+
+func resourceAwsEc2TrafficMirrorFilterDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	input := &ec2.DeleteTrafficMirrorFilterInput{
+		TrafficMirrorFilterId: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting EC2 Traffic Mirror Filter (%s): %s", d.Id(), input)
+	_, err := conn.DeleteTrafficMirrorFilter(input)
+
+	if isAWSErr(err, "InvalidTrafficMirrorFilterId.NotFound", "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 Traffic Mirror Filter (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,296 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsEc2TrafficMirrorFilterRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEc2TrafficMirrorFilterRuleCreate,
+		Read:   resourceAwsEc2TrafficMirrorFilterRuleRead,
+		Update: resourceAwsEc2TrafficMirrorFilterRuleUpdate,
+		Delete: resourceAwsEc2TrafficMirrorFilterRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsEc2TrafficMirrorFilterRuleImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"destination_cidr_block": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateCIDRNetworkAddress,
+			},
+			"destination_port_range": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"from_port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
+						},
+						"to_port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
+						},
+					},
+				},
+			},
+			"protocol": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 255),
+			},
+			"rule_action": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.TrafficMirrorRuleActionAccept,
+					ec2.TrafficMirrorRuleActionReject,
+				}, false),
+			},
+			"rule_number": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 32766),
+			},
+			"source_cidr_block": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateCIDRNetworkAddress,
+			},
+			"source_port_range": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"from_port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
+						},
+						"to_port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
+						},
+					},
+				},
+			},
+			"traffic_direction": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.TrafficDirectionIngress,
+					ec2.TrafficDirectionEgress,
+				}, false),
+			},
+			"traffic_mirror_filter_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceAwsEc2TrafficMirrorFilterRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	input := &ec2.CreateTrafficMirrorFilterRuleInput{
+		DestinationCidrBlock:  aws.String(d.Get("destination_cidr_block").(string)),
+		DestinationPortRange:  expandEc2TrafficMirrorPortRangeRequest(d.Get("destination_port_range").([]interface{})),
+		RuleAction:            aws.String(d.Get("rule_action").(string)),
+		RuleNumber:            aws.Int64(int64(d.Get("rule_number").(int))),
+		SourceCidrBlock:       aws.String(d.Get("source_cidr_block").(string)),
+		SourcePortRange:       expandEc2TrafficMirrorPortRangeRequest(d.Get("source_port_range").([]interface{})),
+		TrafficDirection:      aws.String(d.Get("traffic_direction").(string)),
+		TrafficMirrorFilterId: aws.String(d.Get("traffic_mirror_filter_id").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("protocol"); ok {
+		input.Protocol = aws.Int64(int64(v.(int)))
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Traffic Mirror Filter Rule: %s", input)
+	output, err := conn.CreateTrafficMirrorFilterRule(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating EC2 Traffic Mirror Filter Rule: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.TrafficMirrorFilterRule.TrafficMirrorFilterRuleId))
+
+	return resourceAwsEc2TrafficMirrorFilterRuleRead(d, meta)
+}
+
+func resourceAwsEc2TrafficMirrorFilterRuleRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	trafficMirrorFilterID := d.Get("traffic_mirror_filter_id").(string)
+
+	rule, err := ec2DescribeTrafficMirrorFilterRule(conn, trafficMirrorFilterID, d.Id())
+
+	if isAWSErr(err, "InvalidTrafficMirrorFilterId.NotFound", "") {
+		log.Printf("[WARN] EC2 Traffic Mirror Filter (%s) not found, removing rule (%s) from state", trafficMirrorFilterID, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Traffic Mirror Filter Rule (%s): %s", d.Id(), err)
+	}
+
+	if rule == nil {
+		log.Printf("[WARN] EC2 Traffic Mirror Filter Rule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("description", rule.Description)
+	d.Set("destination_cidr_block", rule.DestinationCidrBlock)
+
+	if err := d.Set("destination_port_range", flattenEc2TrafficMirrorPortRange(rule.DestinationPortRange)); err != nil {
+		return fmt.Errorf("error setting destination_port_range: %s", err)
+	}
+
+	d.Set("protocol", int(aws.Int64Value(rule.Protocol)))
+	d.Set("rule_action", rule.RuleAction)
+	d.Set("rule_number", int(aws.Int64Value(rule.RuleNumber)))
+	d.Set("source_cidr_block", rule.SourceCidrBlock)
+
+	if err := d.Set("source_port_range", flattenEc2TrafficMirrorPortRange(rule.SourcePortRange)); err != nil {
+		return fmt.Errorf("error setting source_port_range: %s", err)
+	}
+
+	d.Set("traffic_direction", rule.TrafficDirection)
+	d.Set("traffic_mirror_filter_id", rule.TrafficMirrorFilterId)
+
+	return nil
+}
+
+func resourceAwsEc2TrafficMirrorFilterRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	input := &ec2.ModifyTrafficMirrorFilterRuleInput{
+		TrafficMirrorFilterRuleId: aws.String(d.Id()),
+	}
+
+	var removeFields []*string
+
+	if d.HasChange("description") {
+		if v, ok := d.GetOk("description"); ok {
+			input.Description = aws.String(v.(string))
+		} else {
+			removeFields = append(removeFields, aws.String(ec2.TrafficMirrorFilterRuleFieldDescription))
+		}
+	}
+
+	if d.HasChange("destination_cidr_block") {
+		input.DestinationCidrBlock = aws.String(d.Get("destination_cidr_block").(string))
+	}
+
+	if d.HasChange("destination_port_range") {
+		if v := expandEc2TrafficMirrorPortRangeRequest(d.Get("destination_port_range").([]interface{})); v != nil {
+			input.DestinationPortRange = v
+		} else {
+			removeFields = append(removeFields, aws.String(ec2.TrafficMirrorFilterRuleFieldDestinationPortRange))
+		}
+	}
+
+	if d.HasChange("protocol") {
+		if v, ok := d.GetOk("protocol"); ok {
+			input.Protocol = aws.Int64(int64(v.(int)))
+		} else {
+			removeFields = append(removeFields, aws.String(ec2.TrafficMirrorFilterRuleFieldProtocol))
+		}
+	}
+
+	if d.HasChange("rule_action") {
+		input.RuleAction = aws.String(d.Get("rule_action").(string))
+	}
+
+	if d.HasChange("rule_number") {
+		input.RuleNumber = aws.Int64(int64(d.Get("rule_number").(int)))
+	}
+
+	if d.HasChange("source_cidr_block") {
+		input.SourceCidrBlock = aws.String(d.Get("source_cidr_block").(string))
+	}
+
+	if d.HasChange("source_port_range") {
+		if v := expandEc2TrafficMirrorPortRangeRequest(d.Get("source_port_range").([]interface{})); v != nil {
+			input.SourcePortRange = v
+		} else {
+			removeFields = append(removeFields, aws.String(ec2.TrafficMirrorFilterRuleFieldSourcePortRange))
+		}
+	}
+
+	if d.HasChange("traffic_direction") {
+		input.TrafficDirection = aws.String(d.Get("traffic_direction").(string))
+	}
+
+	if len(removeFields) > 0 {
+		input.RemoveFields = removeFields
+	}
+
+	log.Printf("[DEBUG] Updating EC2 Traffic Mirror Filter Rule (%s): %s", d.Id(), input)
+	if _, err := conn.ModifyTrafficMirrorFilterRule(input); err != nil {
+		return fmt.Errorf("error updating EC2 Traffic Mirror Filter Rule (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsEc2TrafficMirrorFilterRuleRead(d, meta)
+}
+
+func resourceAwsEc2TrafficMirrorFilterRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	input := &ec2.DeleteTrafficMirrorFilterRuleInput{
+		TrafficMirrorFilterRuleId: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting EC2 Traffic Mirror Filter Rule (%s): %s", d.Id(), input)
+	_, err := conn.DeleteTrafficMirrorFilterRule(input)
+
+	if isAWSErr(err, "InvalidTrafficMirrorFilterRuleId.NotFound", "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 Traffic Mirror Filter Rule (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsEc2TrafficMirrorFilterRuleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	trafficMirrorFilterID, trafficMirrorFilterRuleID, err := decodeEc2TrafficMirrorFilterRuleID(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(trafficMirrorFilterRuleID)
+	d.Set("traffic_mirror_filter_id", trafficMirrorFilterID)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,220 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSEc2TrafficMirrorFilterRule_basic(t *testing.T) {
+	var rule1, rule2 ec2.TrafficMirrorFilterRule
+	resourceName := "aws_ec2_traffic_mirror_filter_rule.test"
+	filterResourceName := "aws_ec2_traffic_mirror_filter.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEc2TrafficMirror(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2TrafficMirrorFilterRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2TrafficMirrorFilterRuleConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TrafficMirrorFilterRuleExists(resourceName, &rule1),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "destination_cidr_block", "10.0.0.0/8"),
+					resource.TestCheckResourceAttr(resourceName, "destination_port_range.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "protocol", "0"),
+					resource.TestCheckResourceAttr(resourceName, "rule_action", "accept"),
+					resource.TestCheckResourceAttr(resourceName, "rule_number", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source_cidr_block", "0.0.0.0/0"),
+					resource.TestCheckResourceAttr(resourceName, "source_port_range.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_direction", "ingress"),
+					resource.TestCheckResourceAttrPair(resourceName, "traffic_mirror_filter_id", filterResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSEc2TrafficMirrorFilterRuleImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSEc2TrafficMirrorFilterRuleConfigFull(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TrafficMirrorFilterRuleExists(resourceName, &rule2),
+					testAccCheckAWSEc2TrafficMirrorFilterRuleNotRecreated(&rule1, &rule2),
+					resource.TestCheckResourceAttr(resourceName, "description", "test rule"),
+					resource.TestCheckResourceAttr(resourceName, "destination_cidr_block", "10.1.0.0/16"),
+					resource.TestCheckResourceAttr(resourceName, "destination_port_range.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "destination_port_range.0.from_port", "22"),
+					resource.TestCheckResourceAttr(resourceName, "destination_port_range.0.to_port", "53"),
+					resource.TestCheckResourceAttr(resourceName, "protocol", "6"),
+					resource.TestCheckResourceAttr(resourceName, "rule_action", "reject"),
+					resource.TestCheckResourceAttr(resourceName, "rule_number", "2"),
+					resource.TestCheckResourceAttr(resourceName, "source_cidr_block", "10.2.0.0/16"),
+					resource.TestCheckResourceAttr(resourceName, "source_port_range.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source_port_range.0.from_port", "1024"),
+					resource.TestCheckResourceAttr(resourceName, "source_port_range.0.to_port", "65535"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_direction", "egress"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2TrafficMirrorFilterRule_disappears(t *testing.T) {
+	var rule1 ec2.TrafficMirrorFilterRule
+	resourceName := "aws_ec2_traffic_mirror_filter_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEc2TrafficMirror(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2TrafficMirrorFilterRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2TrafficMirrorFilterRuleConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TrafficMirrorFilterRuleExists(resourceName, &rule1),
+					testAccCheckAWSEc2TrafficMirrorFilterRuleDisappears(&rule1),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEc2TrafficMirrorFilterRuleExists(resourceName string, rule *ec2.TrafficMirrorFilterRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Traffic Mirror Filter Rule ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		output, err := ec2DescribeTrafficMirrorFilterRule(conn, rs.Primary.Attributes["traffic_mirror_filter_id"], rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("EC2 Traffic Mirror Filter Rule (%s) not found", rs.Primary.ID)
+		}
+
+		*rule = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSEc2TrafficMirrorFilterRuleDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_traffic_mirror_filter_rule" {
+			continue
+		}
+
+		rule, err := ec2DescribeTrafficMirrorFilterRule(conn, rs.Primary.Attributes["traffic_mirror_filter_id"], rs.Primary.ID)
+
+		if isAWSErr(err, "InvalidTrafficMirrorFilterId.NotFound", "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if rule != nil {
+			return fmt.Errorf("EC2 Traffic Mirror Filter Rule (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSEc2TrafficMirrorFilterRuleDisappears(rule *ec2.TrafficMirrorFilterRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		input := &ec2.DeleteTrafficMirrorFilterRuleInput{
+			TrafficMirrorFilterRuleId: rule.TrafficMirrorFilterRuleId,
+		}
+
+		_, err := conn.DeleteTrafficMirrorFilterRule(input)
+
+		return err
+	}
+}
+
+func testAccCheckAWSEc2TrafficMirrorFilterRuleNotRecreated(i, j *ec2.TrafficMirrorFilterRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(i.TrafficMirrorFilterRuleId) != aws.StringValue(j.TrafficMirrorFilterRuleId) {
+			return fmt.Errorf("EC2 Traffic Mirror Filter Rule was recreated")
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSEc2TrafficMirrorFilterRuleImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s:%s", rs.Primary.Attributes["traffic_mirror_filter_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccAWSEc2TrafficMirrorFilterRuleConfig() string {
+	return fmt.Sprintf(`
+resource "aws_ec2_traffic_mirror_filter" "test" {}
+
+resource "aws_ec2_traffic_mirror_filter_rule" "test" {
+  destination_cidr_block   = "10.0.0.0/8"
+  rule_action              = "accept"
+  rule_number              = 1
+  source_cidr_block        = "0.0.0.0/0"
+  traffic_direction        = "ingress"
+  traffic_mirror_filter_id = "${aws_ec2_traffic_mirror_filter.test.id}"
+}
+`)
+}
+
+func testAccAWSEc2TrafficMirrorFilterRuleConfigFull() string {
+	return fmt.Sprintf(`
+resource "aws_ec2_traffic_mirror_filter" "test" {}
+
+resource "aws_ec2_traffic_mirror_filter_rule" "test" {
+  description              = "test rule"
+  destination_cidr_block   = "10.1.0.0/16"
+  protocol                 = 6
+  rule_action              = "reject"
+  rule_number              = 2
+  source_cidr_block        = "10.2.0.0/16"
+  traffic_direction        = "egress"
+  traffic_mirror_filter_id = "${aws_ec2_traffic_mirror_filter.test.id}"
+
+  destination_port_range {
+    from_port = 22
+    to_port   = 53
+  }
+
+  source_port_range {
+    from_port = 1024
+    to_port   = 65535
+  }
+}
+`)
+}
@@ -0,0 +1,270 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSEc2TrafficMirrorFilter_basic(t *testing.T) {
+	var trafficMirrorFilter1 ec2.TrafficMirrorFilter
+	resourceName := "aws_ec2_traffic_mirror_filter.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEc2TrafficMirror(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2TrafficMirrorFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2TrafficMirrorFilterConfigDescription(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TrafficMirrorFilterExists(resourceName, &trafficMirrorFilter1),
+					resource.TestCheckResourceAttr(resourceName, "description", rName),
+					resource.TestCheckResourceAttr(resourceName, "network_services.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2TrafficMirrorFilter_disappears(t *testing.T) {
+	var trafficMirrorFilter1 ec2.TrafficMirrorFilter
+	resourceName := "aws_ec2_traffic_mirror_filter.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEc2TrafficMirror(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2TrafficMirrorFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2TrafficMirrorFilterConfigDescription(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TrafficMirrorFilterExists(resourceName, &trafficMirrorFilter1),
+					testAccCheckAWSEc2TrafficMirrorFilterDisappears(&trafficMirrorFilter1),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2TrafficMirrorFilter_NetworkServices(t *testing.T) {
+	var trafficMirrorFilter1, trafficMirrorFilter2, trafficMirrorFilter3 ec2.TrafficMirrorFilter
+	resourceName := "aws_ec2_traffic_mirror_filter.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEc2TrafficMirror(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2TrafficMirrorFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2TrafficMirrorFilterConfigNetworkServices(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TrafficMirrorFilterExists(resourceName, &trafficMirrorFilter1),
+					resource.TestCheckResourceAttr(resourceName, "network_services.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSEc2TrafficMirrorFilterConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TrafficMirrorFilterExists(resourceName, &trafficMirrorFilter2),
+					testAccCheckAWSEc2TrafficMirrorFilterNotRecreated(&trafficMirrorFilter1, &trafficMirrorFilter2),
+					resource.TestCheckResourceAttr(resourceName, "network_services.#", "0"),
+				),
+			},
+			{
+				Config: testAccAWSEc2TrafficMirrorFilterConfigNetworkServices(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TrafficMirrorFilterExists(resourceName, &trafficMirrorFilter3),
+					testAccCheckAWSEc2TrafficMirrorFilterNotRecreated(&trafficMirrorFilter2, &trafficMirrorFilter3),
+					resource.TestCheckResourceAttr(resourceName, "network_services.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2TrafficMirrorFilter_Tags(t *testing.T) {
+	var trafficMirrorFilter1, trafficMirrorFilter2, trafficMirrorFilter3 ec2.TrafficMirrorFilter
+	resourceName := "aws_ec2_traffic_mirror_filter.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEc2TrafficMirror(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2TrafficMirrorFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2TrafficMirrorFilterConfigTags1("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TrafficMirrorFilterExists(resourceName, &trafficMirrorFilter1),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSEc2TrafficMirrorFilterConfigTags2("key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TrafficMirrorFilterExists(resourceName, &trafficMirrorFilter2),
+					testAccCheckAWSEc2TrafficMirrorFilterNotRecreated(&trafficMirrorFilter1, &trafficMirrorFilter2),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSEc2TrafficMirrorFilterConfigTags1("key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TrafficMirrorFilterExists(resourceName, &trafficMirrorFilter3),
+					testAccCheckAWSEc2TrafficMirrorFilterNotRecreated(&trafficMirrorFilter2, &trafficMirrorFilter3),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEc2TrafficMirrorFilterExists(resourceName string, trafficMirrorFilter *ec2.TrafficMirrorFilter) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Traffic Mirror Filter ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		filter, err := ec2DescribeTrafficMirrorFilter(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if filter == nil {
+			return fmt.Errorf("EC2 Traffic Mirror Filter (%s) not found", rs.Primary.ID)
+		}
+
+		*trafficMirrorFilter = *filter
+
+		return nil
+	}
+}
+
+func testAccCheckAWSEc2TrafficMirrorFilterDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_traffic_mirror_filter" {
+			continue
+		}
+
+		filter, err := ec2DescribeTrafficMirrorFilter(conn, rs.Primary.ID)
+
+		if isAWSErr(err, "InvalidTrafficMirrorFilterId.NotFound", "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if filter != nil {
+			return fmt.Errorf("EC2 Traffic Mirror Filter (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSEc2TrafficMirrorFilterDisappears(trafficMirrorFilter *ec2.TrafficMirrorFilter) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		input := &ec2.DeleteTrafficMirrorFilterInput{
+			TrafficMirrorFilterId: trafficMirrorFilter.TrafficMirrorFilterId,
+		}
+
+		_, err := conn.DeleteTrafficMirrorFilter(input)
+
+		return err
+	}
+}
+
+func testAccCheckAWSEc2TrafficMirrorFilterNotRecreated(i, j *ec2.TrafficMirrorFilter) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(i.TrafficMirrorFilterId) != aws.StringValue(j.TrafficMirrorFilterId) {
+			return fmt.Errorf("EC2 Traffic Mirror Filter was recreated")
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSEc2TrafficMirrorFilterConfig() string {
+	return fmt.Sprintf(`
+resource "aws_ec2_traffic_mirror_filter" "test" {}
+`)
+}
+
+func testAccAWSEc2TrafficMirrorFilterConfigDescription(description string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_traffic_mirror_filter" "test" {
+  description = %[1]q
+}
+`, description)
+}
+
+func testAccAWSEc2TrafficMirrorFilterConfigNetworkServices() string {
+	return fmt.Sprintf(`
+resource "aws_ec2_traffic_mirror_filter" "test" {
+  network_services = ["amazon-dns"]
+}
+`)
+}
+
+func testAccAWSEc2TrafficMirrorFilterConfigTags1(tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_traffic_mirror_filter" "test" {
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1)
+}
+
+func testAccAWSEc2TrafficMirrorFilterConfigTags2(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_traffic_mirror_filter" "test" {
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,299 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSEc2TrafficMirrorSession_basic(t *testing.T) {
+	var trafficMirrorSession1, trafficMirrorSession2 ec2.TrafficMirrorSession
+	resourceName := "aws_ec2_traffic_mirror_session.test"
+	filterResourceName := "aws_ec2_traffic_mirror_filter.test"
+	targetResourceName := "aws_ec2_traffic_mirror_target.test"
+	instanceResourceName := "aws_instance.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEc2TrafficMirror(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2TrafficMirrorSessionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2TrafficMirrorSessionConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TrafficMirrorSessionExists(resourceName, &trafficMirrorSession1),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "network_interface_id", instanceResourceName, "primary_network_interface_id"),
+					testAccCheckResourceAttrAccountID(resourceName, "owner_id"),
+					resource.TestCheckResourceAttr(resourceName, "packet_length", "0"),
+					resource.TestCheckResourceAttr(resourceName, "session_number", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "traffic_mirror_filter_id", filterResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "traffic_mirror_target_id", targetResourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "virtual_network_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSEc2TrafficMirrorSessionConfigUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TrafficMirrorSessionExists(resourceName, &trafficMirrorSession2),
+					testAccCheckAWSEc2TrafficMirrorSessionNotRecreated(&trafficMirrorSession1, &trafficMirrorSession2),
+					resource.TestCheckResourceAttr(resourceName, "description", rName),
+					resource.TestCheckResourceAttr(resourceName, "packet_length", "200"),
+					resource.TestCheckResourceAttr(resourceName, "session_number", "2"),
+					resource.TestCheckResourceAttr(resourceName, "virtual_network_id", "12345"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2TrafficMirrorSession_disappears(t *testing.T) {
+	var trafficMirrorSession1 ec2.TrafficMirrorSession
+	resourceName := "aws_ec2_traffic_mirror_session.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEc2TrafficMirror(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2TrafficMirrorSessionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2TrafficMirrorSessionConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TrafficMirrorSessionExists(resourceName, &trafficMirrorSession1),
+					testAccCheckAWSEc2TrafficMirrorSessionDisappears(&trafficMirrorSession1),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2TrafficMirrorSession_Tags(t *testing.T) {
+	var trafficMirrorSession1, trafficMirrorSession2, trafficMirrorSession3 ec2.TrafficMirrorSession
+	resourceName := "aws_ec2_traffic_mirror_session.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEc2TrafficMirror(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2TrafficMirrorSessionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2TrafficMirrorSessionConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TrafficMirrorSessionExists(resourceName, &trafficMirrorSession1),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSEc2TrafficMirrorSessionConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TrafficMirrorSessionExists(resourceName, &trafficMirrorSession2),
+					testAccCheckAWSEc2TrafficMirrorSessionNotRecreated(&trafficMirrorSession1, &trafficMirrorSession2),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSEc2TrafficMirrorSessionConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TrafficMirrorSessionExists(resourceName, &trafficMirrorSession3),
+					testAccCheckAWSEc2TrafficMirrorSessionNotRecreated(&trafficMirrorSession2, &trafficMirrorSession3),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEc2TrafficMirrorSessionExists(resourceName string, trafficMirrorSession *ec2.TrafficMirrorSession) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Traffic Mirror Session ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		session, err := ec2DescribeTrafficMirrorSession(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if session == nil {
+			return fmt.Errorf("EC2 Traffic Mirror Session (%s) not found", rs.Primary.ID)
+		}
+
+		*trafficMirrorSession = *session
+
+		return nil
+	}
+}
+
+func testAccCheckAWSEc2TrafficMirrorSessionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_traffic_mirror_session" {
+			continue
+		}
+
+		session, err := ec2DescribeTrafficMirrorSession(conn, rs.Primary.ID)
+
+		if isAWSErr(err, "InvalidTrafficMirrorSessionId.NotFound", "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if session != nil {
+			return fmt.Errorf("EC2 Traffic Mirror Session (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSEc2TrafficMirrorSessionDisappears(trafficMirrorSession *ec2.TrafficMirrorSession) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		input := &ec2.DeleteTrafficMirrorSessionInput{
+			TrafficMirrorSessionId: trafficMirrorSession.TrafficMirrorSessionId,
+		}
+
+		_, err := conn.DeleteTrafficMirrorSession(input)
+
+		return err
+	}
+}
+
+func testAccCheckAWSEc2TrafficMirrorSessionNotRecreated(i, j *ec2.TrafficMirrorSession) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(i.TrafficMirrorSessionId) != aws.StringValue(j.TrafficMirrorSessionId) {
+			return fmt.Errorf("EC2 Traffic Mirror Session was recreated")
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSEc2TrafficMirrorSessionConfigBase(rName string) string {
+	return testAccAWSEc2TrafficMirrorTargetConfigBase(rName) + fmt.Sprintf(`
+data "aws_ami" "amzn-ami-minimal-hvm-ebs" {
+  most_recent = true
+  owners      = ["amazon"]
+
+  filter {
+    name   = "name"
+    values = ["amzn-ami-minimal-hvm-*"]
+  }
+
+  filter {
+    name   = "root-device-type"
+    values = ["ebs"]
+  }
+}
+
+# Traffic mirror sources must be attached to a Nitro based instance
+resource "aws_instance" "test" {
+  ami           = "${data.aws_ami.amzn-ami-minimal-hvm-ebs.id}"
+  instance_type = "m5.large"
+  subnet_id     = "${aws_subnet.test.id}"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_traffic_mirror_filter" "test" {}
+
+resource "aws_ec2_traffic_mirror_target" "test" {
+  network_interface_id = "${aws_network_interface.test.id}"
+}
+`, rName)
+}
+
+func testAccAWSEc2TrafficMirrorSessionConfig(rName string) string {
+	return testAccAWSEc2TrafficMirrorSessionConfigBase(rName) + fmt.Sprintf(`
+resource "aws_ec2_traffic_mirror_session" "test" {
+  network_interface_id     = "${aws_instance.test.primary_network_interface_id}"
+  session_number           = 1
+  traffic_mirror_filter_id = "${aws_ec2_traffic_mirror_filter.test.id}"
+  traffic_mirror_target_id = "${aws_ec2_traffic_mirror_target.test.id}"
+}
+`)
+}
+
+func testAccAWSEc2TrafficMirrorSessionConfigUpdated(rName string) string {
+	return testAccAWSEc2TrafficMirrorSessionConfigBase(rName) + fmt.Sprintf(`
+resource "aws_ec2_traffic_mirror_session" "test" {
+  description              = %[1]q
+  network_interface_id     = "${aws_instance.test.primary_network_interface_id}"
+  packet_length            = 200
+  session_number           = 2
+  traffic_mirror_filter_id = "${aws_ec2_traffic_mirror_filter.test.id}"
+  traffic_mirror_target_id = "${aws_ec2_traffic_mirror_target.test.id}"
+  virtual_network_id       = 12345
+}
+`, rName)
+}
+
+func testAccAWSEc2TrafficMirrorSessionConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return testAccAWSEc2TrafficMirrorSessionConfigBase(rName) + fmt.Sprintf(`
+resource "aws_ec2_traffic_mirror_session" "test" {
+  network_interface_id     = "${aws_instance.test.primary_network_interface_id}"
+  session_number           = 1
+  traffic_mirror_filter_id = "${aws_ec2_traffic_mirror_filter.test.id}"
+  traffic_mirror_target_id = "${aws_ec2_traffic_mirror_target.test.id}"
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1)
+}
+
+func testAccAWSEc2TrafficMirrorSessionConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return testAccAWSEc2TrafficMirrorSessionConfigBase(rName) + fmt.Sprintf(`
+resource "aws_ec2_traffic_mirror_session" "test" {
+  network_interface_id     = "${aws_instance.test.primary_network_interface_id}"
+  session_number           = 1
+  traffic_mirror_filter_id = "${aws_ec2_traffic_mirror_filter.test.id}"
+  traffic_mirror_target_id = "${aws_ec2_traffic_mirror_target.test.id}"
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,321 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSEc2TrafficMirrorTarget_NetworkInterfaceId(t *testing.T) {
+	var trafficMirrorTarget1 ec2.TrafficMirrorTarget
+	resourceName := "aws_ec2_traffic_mirror_target.test"
+	networkInterfaceResourceName := "aws_network_interface.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEc2TrafficMirror(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2TrafficMirrorTargetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2TrafficMirrorTargetConfigNetworkInterfaceId(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TrafficMirrorTargetExists(resourceName, &trafficMirrorTarget1),
+					resource.TestCheckResourceAttr(resourceName, "description", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "network_interface_id", networkInterfaceResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "network_load_balancer_arn", ""),
+					testAccCheckResourceAttrAccountID(resourceName, "owner_id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "type", ec2.TrafficMirrorTargetTypeNetworkInterface),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2TrafficMirrorTarget_NetworkLoadBalancerArn(t *testing.T) {
+	var trafficMirrorTarget1 ec2.TrafficMirrorTarget
+	resourceName := "aws_ec2_traffic_mirror_target.test"
+	lbResourceName := "aws_lb.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEc2TrafficMirror(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2TrafficMirrorTargetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2TrafficMirrorTargetConfigNetworkLoadBalancerArn(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TrafficMirrorTargetExists(resourceName, &trafficMirrorTarget1),
+					resource.TestCheckResourceAttr(resourceName, "network_interface_id", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "network_load_balancer_arn", lbResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "type", ec2.TrafficMirrorTargetTypeNetworkLoadBalancer),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2TrafficMirrorTarget_disappears(t *testing.T) {
+	var trafficMirrorTarget1 ec2.TrafficMirrorTarget
+	resourceName := "aws_ec2_traffic_mirror_target.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEc2TrafficMirror(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2TrafficMirrorTargetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2TrafficMirrorTargetConfigNetworkInterfaceId(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TrafficMirrorTargetExists(resourceName, &trafficMirrorTarget1),
+					testAccCheckAWSEc2TrafficMirrorTargetDisappears(&trafficMirrorTarget1),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEc2TrafficMirrorTarget_Tags(t *testing.T) {
+	var trafficMirrorTarget1, trafficMirrorTarget2, trafficMirrorTarget3 ec2.TrafficMirrorTarget
+	resourceName := "aws_ec2_traffic_mirror_target.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSEc2TrafficMirror(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2TrafficMirrorTargetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2TrafficMirrorTargetConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TrafficMirrorTargetExists(resourceName, &trafficMirrorTarget1),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSEc2TrafficMirrorTargetConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TrafficMirrorTargetExists(resourceName, &trafficMirrorTarget2),
+					testAccCheckAWSEc2TrafficMirrorTargetNotRecreated(&trafficMirrorTarget1, &trafficMirrorTarget2),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSEc2TrafficMirrorTargetConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2TrafficMirrorTargetExists(resourceName, &trafficMirrorTarget3),
+					testAccCheckAWSEc2TrafficMirrorTargetNotRecreated(&trafficMirrorTarget2, &trafficMirrorTarget3),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPreCheckAWSEc2TrafficMirror(t *testing.T) {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	input := &ec2.DescribeTrafficMirrorFiltersInput{
+		MaxResults: aws.Int64(5),
+	}
+
+	_, err := conn.DescribeTrafficMirrorFilters(input)
+
+	if testAccPreCheckSkipError(err) || isAWSErr(err, "InvalidAction", "") {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccCheckAWSEc2TrafficMirrorTargetExists(resourceName string, trafficMirrorTarget *ec2.TrafficMirrorTarget) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Traffic Mirror Target ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		target, err := ec2DescribeTrafficMirrorTarget(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if target == nil {
+			return fmt.Errorf("EC2 Traffic Mirror Target (%s) not found", rs.Primary.ID)
+		}
+
+		*trafficMirrorTarget = *target
+
+		return nil
+	}
+}
+
+func testAccCheckAWSEc2TrafficMirrorTargetDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_traffic_mirror_target" {
+			continue
+		}
+
+		target, err := ec2DescribeTrafficMirrorTarget(conn, rs.Primary.ID)
+
+		if isAWSErr(err, "InvalidTrafficMirrorTargetId.NotFound", "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if target != nil {
+			return fmt.Errorf("EC2 Traffic Mirror Target (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSEc2TrafficMirrorTargetDisappears(trafficMirrorTarget *ec2.TrafficMirrorTarget) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		input := &ec2.DeleteTrafficMirrorTargetInput{
+			TrafficMirrorTargetId: trafficMirrorTarget.TrafficMirrorTargetId,
+		}
+
+		_, err := conn.DeleteTrafficMirrorTarget(input)
+
+		return err
+	}
+}
+
+func testAccCheckAWSEc2TrafficMirrorTargetNotRecreated(i, j *ec2.TrafficMirrorTarget) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(i.TrafficMirrorTargetId) != aws.StringValue(j.TrafficMirrorTargetId) {
+			return fmt.Errorf("EC2 Traffic Mirror Target was recreated")
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSEc2TrafficMirrorTargetConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {
+  state = "available"
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+  cidr_block        = "10.0.0.0/24"
+  vpc_id            = "${aws_vpc.test.id}"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_network_interface" "test" {
+  subnet_id = "${aws_subnet.test.id}"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccAWSEc2TrafficMirrorTargetConfigNetworkInterfaceId(rName string) string {
+	return testAccAWSEc2TrafficMirrorTargetConfigBase(rName) + fmt.Sprintf(`
+resource "aws_ec2_traffic_mirror_target" "test" {
+  description          = %[1]q
+  network_interface_id = "${aws_network_interface.test.id}"
+}
+`, rName)
+}
+
+func testAccAWSEc2TrafficMirrorTargetConfigNetworkLoadBalancerArn(rName string) string {
+	return testAccAWSEc2TrafficMirrorTargetConfigBase(rName) + fmt.Sprintf(`
+resource "aws_lb" "test" {
+  internal           = true
+  load_balancer_type = "network"
+  name               = %[1]q
+  subnets            = ["${aws_subnet.test.id}"]
+}
+
+resource "aws_ec2_traffic_mirror_target" "test" {
+  network_load_balancer_arn = "${aws_lb.test.arn}"
+}
+`, rName)
+}
+
+func testAccAWSEc2TrafficMirrorTargetConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return testAccAWSEc2TrafficMirrorTargetConfigBase(rName) + fmt.Sprintf(`
+resource "aws_ec2_traffic_mirror_target" "test" {
+  network_interface_id = "${aws_network_interface.test.id}"
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1)
+}
+
+func testAccAWSEc2TrafficMirrorTargetConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return testAccAWSEc2TrafficMirrorTargetConfigBase(rName) + fmt.Sprintf(`
+resource "aws_ec2_traffic_mirror_target" "test" {
+  network_interface_id = "${aws_network_interface.test.id}"
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2)
+}
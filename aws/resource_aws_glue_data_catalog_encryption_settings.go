@@ -0,0 +1,233 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsGlueDataCatalogEncryptionSettings() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGlueDataCatalogEncryptionSettingsPut,
+		Read:   resourceAwsGlueDataCatalogEncryptionSettingsRead,
+		Update: resourceAwsGlueDataCatalogEncryptionSettingsPut,
+		Delete: resourceAwsGlueDataCatalogEncryptionSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:     schema.TypeString,
+				ForceNew: true,
+				Optional: true,
+				Computed: true,
+			},
+			"data_catalog_encryption_settings": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"connection_password_encryption": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"aws_kms_key_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"return_connection_password_encrypted": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+								},
+							},
+						},
+						"encryption_at_rest": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"catalog_encryption_mode": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											glue.CatalogEncryptionModeDisabled,
+											glue.CatalogEncryptionModeSseKms,
+										}, false),
+									},
+									"sse_aws_kms_key_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsGlueDataCatalogEncryptionSettingsPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+	catalogID := createAwsGlueCatalogID(d, meta.(*AWSClient).accountid)
+
+	input := &glue.PutDataCatalogEncryptionSettingsInput{
+		CatalogId:                     aws.String(catalogID),
+		DataCatalogEncryptionSettings: expandGlueDataCatalogEncryptionSettings(d.Get("data_catalog_encryption_settings").([]interface{})),
+	}
+
+	log.Printf("[DEBUG] Putting Glue Data Catalog Encryption Settings: %s", input)
+	_, err := conn.PutDataCatalogEncryptionSettings(input)
+	if err != nil {
+		return fmt.Errorf("error putting Glue Data Catalog Encryption Settings (%s): %s", catalogID, err)
+	}
+
+	d.SetId(catalogID)
+
+	return resourceAwsGlueDataCatalogEncryptionSettingsRead(d, meta)
+}
+
+func resourceAwsGlueDataCatalogEncryptionSettingsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	input := &glue.GetDataCatalogEncryptionSettingsInput{
+		CatalogId: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Reading Glue Data Catalog Encryption Settings: %s", input)
+	output, err := conn.GetDataCatalogEncryptionSettings(input)
+	if err != nil {
+		return fmt.Errorf("error reading Glue Data Catalog Encryption Settings (%s): %s", d.Id(), err)
+	}
+
+	d.Set("catalog_id", d.Id())
+
+	if err := d.Set("data_catalog_encryption_settings", flattenGlueDataCatalogEncryptionSettings(output.DataCatalogEncryptionSettings)); err != nil {
+		return fmt.Errorf("error setting data_catalog_encryption_settings: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsGlueDataCatalogEncryptionSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	input := &glue.PutDataCatalogEncryptionSettingsInput{
+		CatalogId: aws.String(d.Id()),
+		DataCatalogEncryptionSettings: &glue.DataCatalogEncryptionSettings{
+			ConnectionPasswordEncryption: &glue.ConnectionPasswordEncryption{
+				ReturnConnectionPasswordEncrypted: aws.Bool(false),
+			},
+			EncryptionAtRest: &glue.EncryptionAtRest{
+				CatalogEncryptionMode: aws.String(glue.CatalogEncryptionModeDisabled),
+			},
+		},
+	}
+
+	log.Printf("[DEBUG] Resetting Glue Data Catalog Encryption Settings: %s", input)
+	_, err := conn.PutDataCatalogEncryptionSettings(input)
+	if err != nil {
+		return fmt.Errorf("error resetting Glue Data Catalog Encryption Settings (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandGlueDataCatalogEncryptionSettings(l []interface{}) *glue.DataCatalogEncryptionSettings {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &glue.DataCatalogEncryptionSettings{
+		ConnectionPasswordEncryption: expandGlueConnectionPasswordEncryption(m["connection_password_encryption"].([]interface{})),
+		EncryptionAtRest:             expandGlueEncryptionAtRest(m["encryption_at_rest"].([]interface{})),
+	}
+}
+
+func expandGlueConnectionPasswordEncryption(l []interface{}) *glue.ConnectionPasswordEncryption {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	connectionPasswordEncryption := &glue.ConnectionPasswordEncryption{
+		ReturnConnectionPasswordEncrypted: aws.Bool(m["return_connection_password_encrypted"].(bool)),
+	}
+
+	if v, ok := m["aws_kms_key_id"].(string); ok && v != "" {
+		connectionPasswordEncryption.AwsKmsKeyId = aws.String(v)
+	}
+
+	return connectionPasswordEncryption
+}
+
+func expandGlueEncryptionAtRest(l []interface{}) *glue.EncryptionAtRest {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	encryptionAtRest := &glue.EncryptionAtRest{
+		CatalogEncryptionMode: aws.String(m["catalog_encryption_mode"].(string)),
+	}
+
+	if v, ok := m["sse_aws_kms_key_id"].(string); ok && v != "" {
+		encryptionAtRest.SseAwsKmsKeyId = aws.String(v)
+	}
+
+	return encryptionAtRest
+}
+
+func flattenGlueDataCatalogEncryptionSettings(settings *glue.DataCatalogEncryptionSettings) []interface{} {
+	if settings == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"connection_password_encryption": flattenGlueConnectionPasswordEncryption(settings.ConnectionPasswordEncryption),
+		"encryption_at_rest":             flattenGlueEncryptionAtRest(settings.EncryptionAtRest),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenGlueConnectionPasswordEncryption(connectionPasswordEncryption *glue.ConnectionPasswordEncryption) []interface{} {
+	if connectionPasswordEncryption == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"aws_kms_key_id":                       aws.StringValue(connectionPasswordEncryption.AwsKmsKeyId),
+		"return_connection_password_encrypted": aws.BoolValue(connectionPasswordEncryption.ReturnConnectionPasswordEncrypted),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenGlueEncryptionAtRest(encryptionAtRest *glue.EncryptionAtRest) []interface{} {
+	if encryptionAtRest == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"catalog_encryption_mode": aws.StringValue(encryptionAtRest.CatalogEncryptionMode),
+		"sse_aws_kms_key_id":      aws.StringValue(encryptionAtRest.SseAwsKmsKeyId),
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,284 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsGluePartition() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGluePartitionCreate,
+		Read:   resourceAwsGluePartitionRead,
+		Update: resourceAwsGluePartitionUpdate,
+		Delete: resourceAwsGluePartitionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:     schema.TypeString,
+				ForceNew: true,
+				Optional: true,
+				Computed: true,
+			},
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"database_name": {
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"last_accessed_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_analyzed_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"partition_values": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 1024),
+				},
+			},
+			"storage_descriptor": glueStorageDescriptorSchema(),
+			"table_name": {
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+		},
+	}
+}
+
+func resourceAwsGluePartitionCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+	catalogID := createAwsGlueCatalogID(d, meta.(*AWSClient).accountid)
+	dbName := d.Get("database_name").(string)
+	tableName := d.Get("table_name").(string)
+	values := expandStringList(d.Get("partition_values").([]interface{}))
+
+	input := &glue.CreatePartitionInput{
+		CatalogId:      aws.String(catalogID),
+		DatabaseName:   aws.String(dbName),
+		PartitionInput: expandGluePartitionInput(d),
+		TableName:      aws.String(tableName),
+	}
+
+	log.Printf("[DEBUG] Creating Glue Partition: %s", input)
+	_, err := conn.CreatePartition(input)
+	if err != nil {
+		return fmt.Errorf("error creating Glue Partition: %s", err)
+	}
+
+	d.SetId(gluePartitionID(catalogID, dbName, tableName, aws.StringValueSlice(values)))
+
+	return resourceAwsGluePartitionRead(d, meta)
+}
+
+func resourceAwsGluePartitionRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	catalogID, dbName, tableName, values, err := decodeGluePartitionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &glue.GetPartitionInput{
+		CatalogId:       aws.String(catalogID),
+		DatabaseName:    aws.String(dbName),
+		PartitionValues: aws.StringSlice(values),
+		TableName:       aws.String(tableName),
+	}
+
+	log.Printf("[DEBUG] Reading Glue Partition: %s", input)
+	output, err := conn.GetPartition(input)
+	if err != nil {
+		if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+			log.Printf("[WARN] Glue Partition (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading Glue Partition (%s): %s", d.Id(), err)
+	}
+
+	partition := output.Partition
+	if partition == nil {
+		log.Printf("[WARN] Glue Partition (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("catalog_id", catalogID)
+	d.Set("database_name", partition.DatabaseName)
+	d.Set("table_name", partition.TableName)
+
+	if err := d.Set("partition_values", aws.StringValueSlice(partition.Values)); err != nil {
+		return fmt.Errorf("error setting partition_values: %s", err)
+	}
+
+	if err := d.Set("parameters", aws.StringValueMap(partition.Parameters)); err != nil {
+		return fmt.Errorf("error setting parameters: %s", err)
+	}
+
+	if err := d.Set("storage_descriptor", flattenGlueStorageDescriptor(partition.StorageDescriptor)); err != nil {
+		return fmt.Errorf("error setting storage_descriptor: %s", err)
+	}
+
+	d.Set("creation_time", "")
+	if partition.CreationTime != nil {
+		d.Set("creation_time", aws.TimeValue(partition.CreationTime).Format(time.RFC3339))
+	}
+
+	d.Set("last_accessed_time", "")
+	if partition.LastAccessTime != nil {
+		d.Set("last_accessed_time", aws.TimeValue(partition.LastAccessTime).Format(time.RFC3339))
+	}
+
+	d.Set("last_analyzed_time", "")
+	if partition.LastAnalyzedTime != nil {
+		d.Set("last_analyzed_time", aws.TimeValue(partition.LastAnalyzedTime).Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func resourceAwsGluePartitionUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	catalogID, dbName, tableName, values, err := decodeGluePartitionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &glue.UpdatePartitionInput{
+		CatalogId:          aws.String(catalogID),
+		DatabaseName:       aws.String(dbName),
+		PartitionInput:     expandGluePartitionInput(d),
+		PartitionValueList: aws.StringSlice(values),
+		TableName:          aws.String(tableName),
+	}
+
+	log.Printf("[DEBUG] Updating Glue Partition: %s", input)
+	_, err = conn.UpdatePartition(input)
+	if err != nil {
+		return fmt.Errorf("error updating Glue Partition (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsGluePartitionRead(d, meta)
+}
+
+func resourceAwsGluePartitionDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).glueconn
+
+	catalogID, dbName, tableName, values, err := decodeGluePartitionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Glue Partition: %s", d.Id())
+	_, err = conn.DeletePartition(&glue.DeletePartitionInput{
+		CatalogId:       aws.String(catalogID),
+		DatabaseName:    aws.String(dbName),
+		PartitionValues: aws.StringSlice(values),
+		TableName:       aws.String(tableName),
+	})
+	if err != nil {
+		if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("error deleting Glue Partition (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandGluePartitionInput(d *schema.ResourceData) *glue.PartitionInput {
+	input := &glue.PartitionInput{
+		Values: expandStringList(d.Get("partition_values").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("parameters"); ok {
+		input.Parameters = stringMapToPointers(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("storage_descriptor"); ok {
+		input.StorageDescriptor = expandGlueStorageDescriptor(v.([]interface{}))
+	}
+
+	return input
+}
+
+// gluePartitionID joins the partition values with "#", escaping any "#" or
+// "\" within the values with a backslash.
+func gluePartitionID(catalogID, dbName, tableName string, values []string) string {
+	escaped := make([]string, len(values))
+	for i, v := range values {
+		escaped[i] = gluePartitionValueEscaper.Replace(v)
+	}
+
+	return fmt.Sprintf("%s:%s:%s:%s", catalogID, dbName, tableName, strings.Join(escaped, "#"))
+}
+
+var gluePartitionValueEscaper = strings.NewReplacer(`\`, `\\`, "#", `\#`)
+
+func decodeGluePartitionID(id string) (catalogID string, dbName string, tableName string, values []string, err error) {
+	idParts := strings.SplitN(id, ":", 4)
+	if len(idParts) != 4 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" || idParts[3] == "" {
+		return "", "", "", nil, fmt.Errorf("expected ID in format catalog-id:database-name:table-name:partition-values, received: %s", id)
+	}
+
+	values, err = decodeGluePartitionValues(idParts[3])
+	if err != nil {
+		return "", "", "", nil, fmt.Errorf("expected ID in format catalog-id:database-name:table-name:partition-values, received: %s: %s", id, err)
+	}
+
+	return idParts[0], idParts[1], idParts[2], values, nil
+}
+
+// decodeGluePartitionValues splits "#" separated partition values, unescaping
+// backslash escaped characters.
+func decodeGluePartitionValues(s string) ([]string, error) {
+	var values []string
+	var value strings.Builder
+
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '\\':
+			if i+1 == len(s) {
+				return nil, fmt.Errorf("trailing escape character in partition values")
+			}
+			i++
+			value.WriteByte(s[i])
+		case '#':
+			values = append(values, value.String())
+			value.Reset()
+		default:
+			value.WriteByte(c)
+		}
+	}
+
+	return append(values, value.String()), nil
+}
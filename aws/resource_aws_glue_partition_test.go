@@ -0,0 +1,375 @@
+package aws
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestDecodeGluePartitionID(t *testing.T) {
+	testCases := []struct {
+		ID        string
+		CatalogID string
+		DBName    string
+		TableName string
+		Values    []string
+		ErrCount  int
+	}{
+		{
+			ID:       "",
+			ErrCount: 1,
+		},
+		{
+			ID:       "123456789012:db:table",
+			ErrCount: 1,
+		},
+		{
+			ID:       "123456789012:db:table:",
+			ErrCount: 1,
+		},
+		{
+			ID:        "123456789012:db:table:2019",
+			CatalogID: "123456789012",
+			DBName:    "db",
+			TableName: "table",
+			Values:    []string{"2019"},
+		},
+		{
+			ID:        "123456789012:db:table:2019#10#a:b",
+			CatalogID: "123456789012",
+			DBName:    "db",
+			TableName: "table",
+			Values:    []string{"2019", "10", "a:b"},
+		},
+		{
+			ID:        `123456789012:db:table:a\#b#c\\d`,
+			CatalogID: "123456789012",
+			DBName:    "db",
+			TableName: "table",
+			Values:    []string{"a#b", `c\d`},
+		},
+		{
+			ID:       `123456789012:db:table:2019\`,
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		catalogID, dbName, tableName, values, err := decodeGluePartitionID(tc.ID)
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("expected %q not to trigger an error, received: %s", tc.ID, err)
+		}
+		if tc.ErrCount > 0 && err == nil {
+			t.Fatalf("expected %q to trigger an error", tc.ID)
+		}
+		if catalogID != tc.CatalogID {
+			t.Fatalf("expected %q to return catalog ID (%s), received: %s", tc.ID, tc.CatalogID, catalogID)
+		}
+		if dbName != tc.DBName {
+			t.Fatalf("expected %q to return database name (%s), received: %s", tc.ID, tc.DBName, dbName)
+		}
+		if tableName != tc.TableName {
+			t.Fatalf("expected %q to return table name (%s), received: %s", tc.ID, tc.TableName, tableName)
+		}
+		if !reflect.DeepEqual(values, tc.Values) {
+			t.Fatalf("expected %q to return partition values (%v), received: %v", tc.ID, tc.Values, values)
+		}
+		if err == nil {
+			if id := gluePartitionID(catalogID, dbName, tableName, values); id != tc.ID {
+				t.Fatalf("expected %q to round trip, received: %s", tc.ID, id)
+			}
+		}
+	}
+}
+
+func TestAccAWSGluePartition_basic(t *testing.T) {
+	var partition glue.Partition
+	rInt := acctest.RandInt()
+	resourceName := "aws_glue_partition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGluePartitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGluePartitionConfig(rInt, "2019"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGluePartitionExists(resourceName, &partition),
+					testAccCheckResourceAttrAccountID(resourceName, "catalog_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_time"),
+					resource.TestCheckResourceAttr(resourceName, "database_name", fmt.Sprintf("tf_acc_test_%d", rInt)),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "partition_values.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "partition_values.0", "2019"),
+					resource.TestCheckResourceAttr(resourceName, "table_name", fmt.Sprintf("tf_acc_test_%d", rInt)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSGluePartition_disappears(t *testing.T) {
+	var partition glue.Partition
+	rInt := acctest.RandInt()
+	resourceName := "aws_glue_partition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGluePartitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGluePartitionConfig(rInt, "2019"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGluePartitionExists(resourceName, &partition),
+					testAccCheckAWSGluePartitionDisappears(resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSGluePartition_Parameters(t *testing.T) {
+	var partition glue.Partition
+	rInt := acctest.RandInt()
+	resourceName := "aws_glue_partition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGluePartitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGluePartitionConfigParameters(rInt, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGluePartitionExists(resourceName, &partition),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSGluePartitionConfigParameters(rInt, "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGluePartitionExists(resourceName, &partition),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.key1", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSGluePartition_StorageDescriptor(t *testing.T) {
+	var partition glue.Partition
+	rInt := acctest.RandInt()
+	resourceName := "aws_glue_partition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGluePartitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGluePartitionConfigStorageDescriptor(rInt, "s3://example-bucket/year=2019/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGluePartitionExists(resourceName, &partition),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.columns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.columns.0.name", "event"),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.location", "s3://example-bucket/year=2019/"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSGluePartitionConfigStorageDescriptor(rInt, "s3://example-bucket/year=2019/updated/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGluePartitionExists(resourceName, &partition),
+					resource.TestCheckResourceAttr(resourceName, "storage_descriptor.0.location", "s3://example-bucket/year=2019/updated/"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSGluePartitionExists(resourceName string, partition *glue.Partition) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		catalogID, dbName, tableName, values, err := decodeGluePartitionID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).glueconn
+
+		output, err := conn.GetPartition(&glue.GetPartitionInput{
+			CatalogId:       aws.String(catalogID),
+			DatabaseName:    aws.String(dbName),
+			PartitionValues: aws.StringSlice(values),
+			TableName:       aws.String(tableName),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || output.Partition == nil {
+			return fmt.Errorf("Glue Partition (%s) not found", rs.Primary.ID)
+		}
+
+		*partition = *output.Partition
+
+		return nil
+	}
+}
+
+func testAccCheckAWSGluePartitionDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).glueconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_glue_partition" {
+			continue
+		}
+
+		catalogID, dbName, tableName, values, err := decodeGluePartitionID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		output, err := conn.GetPartition(&glue.GetPartitionInput{
+			CatalogId:       aws.String(catalogID),
+			DatabaseName:    aws.String(dbName),
+			PartitionValues: aws.StringSlice(values),
+			TableName:       aws.String(tableName),
+		})
+
+		if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil && output.Partition != nil {
+			return fmt.Errorf("Glue Partition (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSGluePartitionDisappears(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		catalogID, dbName, tableName, values, err := decodeGluePartitionID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).glueconn
+
+		_, err = conn.DeletePartition(&glue.DeletePartitionInput{
+			CatalogId:       aws.String(catalogID),
+			DatabaseName:    aws.String(dbName),
+			PartitionValues: aws.StringSlice(values),
+			TableName:       aws.String(tableName),
+		})
+
+		return err
+	}
+}
+
+func testAccAWSGluePartitionConfigBase(rInt int) string {
+	return fmt.Sprintf(`
+resource "aws_glue_catalog_database" "test" {
+  name = "tf_acc_test_%[1]d"
+}
+
+resource "aws_glue_catalog_table" "test" {
+  name          = "tf_acc_test_%[1]d"
+  database_name = "${aws_glue_catalog_database.test.name}"
+
+  partition_keys {
+    name = "year"
+    type = "string"
+  }
+}
+`, rInt)
+}
+
+func testAccAWSGluePartitionConfig(rInt int, value string) string {
+	return testAccAWSGluePartitionConfigBase(rInt) + fmt.Sprintf(`
+resource "aws_glue_partition" "test" {
+  database_name    = "${aws_glue_catalog_database.test.name}"
+  partition_values = [%[1]q]
+  table_name       = "${aws_glue_catalog_table.test.name}"
+}
+`, value)
+}
+
+func testAccAWSGluePartitionConfigParameters(rInt int, value string) string {
+	return testAccAWSGluePartitionConfigBase(rInt) + fmt.Sprintf(`
+resource "aws_glue_partition" "test" {
+  database_name    = "${aws_glue_catalog_database.test.name}"
+  partition_values = ["2019"]
+  table_name       = "${aws_glue_catalog_table.test.name}"
+
+  parameters = {
+    key1 = %[1]q
+  }
+}
+`, value)
+}
+
+func testAccAWSGluePartitionConfigStorageDescriptor(rInt int, location string) string {
+	return testAccAWSGluePartitionConfigBase(rInt) + fmt.Sprintf(`
+resource "aws_glue_partition" "test" {
+  database_name    = "${aws_glue_catalog_database.test.name}"
+  partition_values = ["2019"]
+  table_name       = "${aws_glue_catalog_table.test.name}"
+
+  storage_descriptor {
+    location = %[1]q
+
+    columns {
+      name = "event"
+      type = "string"
+    }
+  }
+}
+`, location)
+}
@@ -0,0 +1,230 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSGlueWorkflow_basic(t *testing.T) {
+	var workflow glue.Workflow
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_glue_workflow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueWorkflowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGlueWorkflowConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueWorkflowExists(resourceName, &workflow),
+					resource.TestCheckResourceAttr(resourceName, "default_run_properties.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSGlueWorkflow_disappears(t *testing.T) {
+	var workflow glue.Workflow
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_glue_workflow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueWorkflowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGlueWorkflowConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueWorkflowExists(resourceName, &workflow),
+					testAccCheckAWSGlueWorkflowDisappears(&workflow),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSGlueWorkflow_DefaultRunProperties(t *testing.T) {
+	var workflow glue.Workflow
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_glue_workflow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueWorkflowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGlueWorkflowConfigDefaultRunProperties(rName, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueWorkflowExists(resourceName, &workflow),
+					resource.TestCheckResourceAttr(resourceName, "default_run_properties.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "default_run_properties.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSGlueWorkflowConfigDefaultRunProperties(rName, "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueWorkflowExists(resourceName, &workflow),
+					resource.TestCheckResourceAttr(resourceName, "default_run_properties.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "default_run_properties.key1", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSGlueWorkflow_Description(t *testing.T) {
+	var workflow glue.Workflow
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_glue_workflow.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSGlueWorkflowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSGlueWorkflowConfigDescription(rName, "First Description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueWorkflowExists(resourceName, &workflow),
+					resource.TestCheckResourceAttr(resourceName, "description", "First Description"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSGlueWorkflowConfigDescription(rName, "Second Description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSGlueWorkflowExists(resourceName, &workflow),
+					resource.TestCheckResourceAttr(resourceName, "description", "Second Description"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSGlueWorkflowExists(resourceName string, workflow *glue.Workflow) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).glueconn
+
+		output, err := conn.GetWorkflow(&glue.GetWorkflowInput{
+			Name: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || output.Workflow == nil {
+			return fmt.Errorf("Glue Workflow (%s) not found", rs.Primary.ID)
+		}
+
+		*workflow = *output.Workflow
+
+		return nil
+	}
+}
+
+func testAccCheckAWSGlueWorkflowDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).glueconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_glue_workflow" {
+			continue
+		}
+
+		output, err := conn.GetWorkflow(&glue.GetWorkflowInput{
+			Name: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, glue.ErrCodeEntityNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil && output.Workflow != nil && aws.StringValue(output.Workflow.Name) == rs.Primary.ID {
+			return fmt.Errorf("Glue Workflow (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSGlueWorkflowDisappears(workflow *glue.Workflow) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).glueconn
+
+		_, err := conn.DeleteWorkflow(&glue.DeleteWorkflowInput{
+			Name: workflow.Name,
+		})
+
+		return err
+	}
+}
+
+func testAccAWSGlueWorkflowConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_workflow" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSGlueWorkflowConfigDefaultRunProperties(rName, value string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_workflow" "test" {
+  name = %[1]q
+
+  default_run_properties = {
+    key1 = %[2]q
+  }
+}
+`, rName, value)
+}
+
+func testAccAWSGlueWorkflowConfigDescription(rName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_glue_workflow" "test" {
+  description = %[2]q
+  name        = %[1]q
+}
+`, rName, description)
+}
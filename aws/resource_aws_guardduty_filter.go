@@ -0,0 +1,400 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsGuardDutyFilter() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsGuardDutyFilterCreate,
+		Read:   resourceAwsGuardDutyFilterRead,
+		Update: resourceAwsGuardDutyFilterUpdate,
+		Delete: resourceAwsGuardDutyFilterDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"action": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					guardduty.FilterActionArchive,
+					guardduty.FilterActionNoop,
+				}, false),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+			"detector_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"finding_criteria": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"criterion": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"equals": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MinItems: 1,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"field": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+									"greater_than": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateGuardDutyFilterConditionValue,
+									},
+									"greater_than_or_equal": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateGuardDutyFilterConditionValue,
+									},
+									"less_than": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateGuardDutyFilterConditionValue,
+									},
+									"less_than_or_equal": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateGuardDutyFilterConditionValue,
+									},
+									"not_equals": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MinItems: 1,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(3, 64),
+			},
+			"rank": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+		},
+	}
+}
+
+func resourceAwsGuardDutyFilterCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).guarddutyconn
+
+	detectorID := d.Get("detector_id").(string)
+	name := d.Get("name").(string)
+
+	findingCriteria, err := expandGuardDutyFindingCriteria(d.Get("finding_criteria").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	input := &guardduty.CreateFilterInput{
+		Action:          aws.String(d.Get("action").(string)),
+		DetectorId:      aws.String(detectorID),
+		FindingCriteria: findingCriteria,
+		Name:            aws.String(name),
+		Rank:            aws.Int64(int64(d.Get("rank").(int))),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating GuardDuty Filter: %s", input)
+	_, err = conn.CreateFilter(input)
+	if err != nil {
+		return fmt.Errorf("error creating GuardDuty Filter (%s): %s", name, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", detectorID, name))
+
+	return resourceAwsGuardDutyFilterRead(d, meta)
+}
+
+func resourceAwsGuardDutyFilterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).guarddutyconn
+
+	detectorID, name, err := decodeGuardDutyFilterID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &guardduty.GetFilterInput{
+		DetectorId: aws.String(detectorID),
+		FilterName: aws.String(name),
+	}
+
+	log.Printf("[DEBUG] Reading GuardDuty Filter: %s", input)
+	output, err := conn.GetFilter(input)
+	if err != nil {
+		if isAWSErr(err, guardduty.ErrCodeBadRequestException, "The request is rejected since no such resource found.") ||
+			isAWSErr(err, guardduty.ErrCodeBadRequestException, "The request is rejected because the input detectorId is not owned by the current account.") {
+			log.Printf("[WARN] GuardDuty Filter (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading GuardDuty Filter (%s): %s", d.Id(), err)
+	}
+
+	d.Set("action", output.Action)
+	d.Set("description", output.Description)
+	d.Set("detector_id", detectorID)
+	d.Set("name", output.Name)
+	d.Set("rank", output.Rank)
+
+	if err := d.Set("finding_criteria", flattenGuardDutyFindingCriteria(output.FindingCriteria)); err != nil {
+		return fmt.Errorf("error setting finding_criteria: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsGuardDutyFilterUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).guarddutyconn
+
+	detectorID, name, err := decodeGuardDutyFilterID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	findingCriteria, err := expandGuardDutyFindingCriteria(d.Get("finding_criteria").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	input := &guardduty.UpdateFilterInput{
+		Action:          aws.String(d.Get("action").(string)),
+		Description:     aws.String(d.Get("description").(string)),
+		DetectorId:      aws.String(detectorID),
+		FilterName:      aws.String(name),
+		FindingCriteria: findingCriteria,
+		Rank:            aws.Int64(int64(d.Get("rank").(int))),
+	}
+
+	log.Printf("[DEBUG] Updating GuardDuty Filter: %s", input)
+	_, err = conn.UpdateFilter(input)
+	if err != nil {
+		return fmt.Errorf("error updating GuardDuty Filter (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsGuardDutyFilterRead(d, meta)
+}
+
+func resourceAwsGuardDutyFilterDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).guarddutyconn
+
+	detectorID, name, err := decodeGuardDutyFilterID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &guardduty.DeleteFilterInput{
+		DetectorId: aws.String(detectorID),
+		FilterName: aws.String(name),
+	}
+
+	log.Printf("[DEBUG] Deleting GuardDuty Filter: %s", input)
+	_, err = conn.DeleteFilter(input)
+	if err != nil {
+		if isAWSErr(err, guardduty.ErrCodeBadRequestException, "The request is rejected since no such resource found.") {
+			return nil
+		}
+		return fmt.Errorf("error deleting GuardDuty Filter (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func decodeGuardDutyFilterID(id string) (detectorID, name string, err error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		err = fmt.Errorf("GuardDuty Filter ID must be of the form <Detector ID>:<Filter Name>, was provided: %s", id)
+		return
+	}
+	detectorID = parts[0]
+	name = parts[1]
+	return
+}
+
+// validateGuardDutyFilterConditionValue accepts either an integer or, for
+// timestamp fields such as updatedAt, an RFC3339 formatted date.
+func validateGuardDutyFilterConditionValue(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if _, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339, value); err == nil {
+		return
+	}
+
+	errors = append(errors, fmt.Errorf("%q must be an integer or an RFC3339 formatted date, got: %s", k, value))
+	return
+}
+
+// guardDutyFilterConditionValue converts a configured condition value to the API representation.
+// RFC3339 dates are sent as epoch milliseconds, as expected by timestamp fields such as updatedAt.
+func guardDutyFilterConditionValue(value string) (*int64, error) {
+	if i, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return aws.Int64(i), nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing GuardDuty Filter condition value (%s): must be an integer or an RFC3339 formatted date", value)
+	}
+
+	return aws.Int64(t.UnixNano() / int64(time.Millisecond)), nil
+}
+
+// flattenGuardDutyFilterConditionValue returns the condition value in the representation used in configuration.
+// Values of timestamp fields are returned as RFC3339 dates.
+func flattenGuardDutyFilterConditionValue(field string, value *int64) string {
+	if value == nil {
+		return ""
+	}
+
+	if field == "updatedAt" {
+		return time.Unix(0, aws.Int64Value(value)*int64(time.Millisecond)).UTC().Format(time.RFC3339)
+	}
+
+	return strconv.FormatInt(aws.Int64Value(value), 10)
+}
+
+func expandGuardDutyFindingCriteria(l []interface{}) (*guardduty.FindingCriteria, error) {
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	criterion := make(map[string]*guardduty.Condition)
+
+	for _, criterionRaw := range m["criterion"].(*schema.Set).List() {
+		criterionMap := criterionRaw.(map[string]interface{})
+		field := criterionMap["field"].(string)
+		condition := &guardduty.Condition{}
+
+		if v, ok := criterionMap["equals"].([]interface{}); ok && len(v) > 0 {
+			condition.Equals = expandStringList(v)
+		}
+
+		if v, ok := criterionMap["not_equals"].([]interface{}); ok && len(v) > 0 {
+			condition.NotEquals = expandStringList(v)
+		}
+
+		for key, dest := range map[string]**int64{
+			"greater_than":          &condition.GreaterThan,
+			"greater_than_or_equal": &condition.GreaterThanOrEqual,
+			"less_than":             &condition.LessThan,
+			"less_than_or_equal":    &condition.LessThanOrEqual,
+		} {
+			v, ok := criterionMap[key].(string)
+			if !ok || v == "" {
+				continue
+			}
+
+			value, err := guardDutyFilterConditionValue(v)
+			if err != nil {
+				return nil, fmt.Errorf("error expanding finding_criteria criterion (%s) %s: %s", field, key, err)
+			}
+
+			*dest = value
+		}
+
+		criterion[field] = condition
+	}
+
+	return &guardduty.FindingCriteria{
+		Criterion: criterion,
+	}, nil
+}
+
+func flattenGuardDutyFindingCriteria(findingCriteria *guardduty.FindingCriteria) []interface{} {
+	if findingCriteria == nil {
+		return []interface{}{}
+	}
+
+	criteria := make([]interface{}, 0, len(findingCriteria.Criterion))
+
+	for field, condition := range findingCriteria.Criterion {
+		if condition == nil {
+			continue
+		}
+
+		// Older filters may only populate the deprecated condition operators.
+		if len(condition.Equals) == 0 {
+			condition.Equals = condition.Eq
+		}
+		if len(condition.NotEquals) == 0 {
+			condition.NotEquals = condition.Neq
+		}
+		if condition.GreaterThan == nil {
+			condition.GreaterThan = condition.Gt
+		}
+		if condition.GreaterThanOrEqual == nil {
+			condition.GreaterThanOrEqual = condition.Gte
+		}
+		if condition.LessThan == nil {
+			condition.LessThan = condition.Lt
+		}
+		if condition.LessThanOrEqual == nil {
+			condition.LessThanOrEqual = condition.Lte
+		}
+
+		criterion := map[string]interface{}{
+			"equals":                aws.StringValueSlice(condition.Equals),
+			"field":                 field,
+			"greater_than":          flattenGuardDutyFilterConditionValue(field, condition.GreaterThan),
+			"greater_than_or_equal": flattenGuardDutyFilterConditionValue(field, condition.GreaterThanOrEqual),
+			"less_than":             flattenGuardDutyFilterConditionValue(field, condition.LessThan),
+			"less_than_or_equal":    flattenGuardDutyFilterConditionValue(field, condition.LessThanOrEqual),
+			"not_equals":            aws.StringValueSlice(condition.NotEquals),
+		}
+
+		criteria = append(criteria, criterion)
+	}
+
+	m := map[string]interface{}{
+		"criterion": criteria,
+	}
+
+	return []interface{}{m}
+}
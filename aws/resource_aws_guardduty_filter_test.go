@@ -0,0 +1,268 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestGuardDutyFilterConditionValue(t *testing.T) {
+	testCases := []struct {
+		Field    string
+		Value    string
+		Expected int64
+		ErrCount int
+	}{
+		{
+			Field:    "severity",
+			Value:    "4",
+			Expected: 4,
+		},
+		{
+			Field:    "updatedAt",
+			Value:    "2019-07-01T00:00:00Z",
+			Expected: 1561939200000,
+		},
+		{
+			Field:    "severity",
+			Value:    "high",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		value, err := guardDutyFilterConditionValue(tc.Value)
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("expected %q not to trigger an error, received: %s", tc.Value, err)
+		}
+		if tc.ErrCount > 0 && err == nil {
+			t.Fatalf("expected %q to trigger an error", tc.Value)
+		}
+		if err != nil {
+			continue
+		}
+		if aws.Int64Value(value) != tc.Expected {
+			t.Fatalf("expected %q to return (%d), received: %d", tc.Value, tc.Expected, aws.Int64Value(value))
+		}
+		if flattened := flattenGuardDutyFilterConditionValue(tc.Field, value); flattened != tc.Value {
+			t.Fatalf("expected %q to round trip, received: %s", tc.Value, flattened)
+		}
+	}
+}
+
+func testAccAwsGuardDutyFilter_basic(t *testing.T) {
+	resourceName := "aws_guardduty_filter.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsGuardDutyFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGuardDutyFilterConfig(rName, "ARCHIVE", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsGuardDutyFilterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "action", "ARCHIVE"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "detector_id", "aws_guardduty_detector.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "finding_criteria.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "finding_criteria.0.criterion.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "rank", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAwsGuardDutyFilter_update(t *testing.T) {
+	resourceName := "aws_guardduty_filter.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsGuardDutyFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGuardDutyFilterConfig(rName, "ARCHIVE", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsGuardDutyFilterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "finding_criteria.0.criterion.#", "3"),
+				),
+			},
+			{
+				Config: testAccGuardDutyFilterConfigUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsGuardDutyFilterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "action", "NOOP"),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "finding_criteria.0.criterion.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rank", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsGuardDutyFilter_disappears(t *testing.T) {
+	resourceName := "aws_guardduty_filter.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsGuardDutyFilterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGuardDutyFilterConfig(rName, "ARCHIVE", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsGuardDutyFilterExists(resourceName),
+					testAccCheckAwsGuardDutyFilterDisappears(resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsGuardDutyFilterDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).guarddutyconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_guardduty_filter" {
+			continue
+		}
+
+		detectorID, name, err := decodeGuardDutyFilterID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.GetFilter(&guardduty.GetFilterInput{
+			DetectorId: aws.String(detectorID),
+			FilterName: aws.String(name),
+		})
+
+		if isAWSErr(err, guardduty.ErrCodeBadRequestException, "The request is rejected since no such resource found.") ||
+			isAWSErr(err, guardduty.ErrCodeBadRequestException, "The request is rejected because the input detectorId is not owned by the current account.") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("GuardDuty Filter (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAwsGuardDutyFilterExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		detectorID, filterName, err := decodeGuardDutyFilterID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).guarddutyconn
+		_, err = conn.GetFilter(&guardduty.GetFilterInput{
+			DetectorId: aws.String(detectorID),
+			FilterName: aws.String(filterName),
+		})
+		return err
+	}
+}
+
+func testAccCheckAwsGuardDutyFilterDisappears(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		detectorID, filterName, err := decodeGuardDutyFilterID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).guarddutyconn
+		_, err = conn.DeleteFilter(&guardduty.DeleteFilterInput{
+			DetectorId: aws.String(detectorID),
+			FilterName: aws.String(filterName),
+		})
+		return err
+	}
+}
+
+func testAccGuardDutyFilterConfig(rName, action string, rank int) string {
+	return testAccGuardDutyDetectorConfig_basic1 + fmt.Sprintf(`
+
+resource "aws_guardduty_filter" "test" {
+  action      = %[2]q
+  detector_id = "${aws_guardduty_detector.test.id}"
+  name        = %[1]q
+  rank        = %[3]d
+
+  finding_criteria {
+    criterion {
+      field  = "region"
+      equals = ["eu-west-1"]
+    }
+
+    criterion {
+      field      = "service.additionalInfo.threatListName"
+      not_equals = ["some-threat", "another-threat"]
+    }
+
+    criterion {
+      field        = "updatedAt"
+      greater_than = "2019-07-01T00:00:00Z"
+      less_than    = "2019-12-31T00:00:00Z"
+    }
+  }
+}
+`, rName, action, rank)
+}
+
+func testAccGuardDutyFilterConfigUpdated(rName string) string {
+	return testAccGuardDutyDetectorConfig_basic1 + fmt.Sprintf(`
+
+resource "aws_guardduty_filter" "test" {
+  action      = "NOOP"
+  description = "updated"
+  detector_id = "${aws_guardduty_detector.test.id}"
+  name        = %[1]q
+  rank        = 1
+
+  finding_criteria {
+    criterion {
+      field  = "region"
+      equals = ["eu-west-1", "eu-west-2"]
+    }
+
+    criterion {
+      field                 = "severity"
+      greater_than_or_equal = "4"
+    }
+  }
+}
+`, rName)
+}
@@ -0,0 +1,270 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform/helper/encryption"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsIamServiceSpecificCredential() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIamServiceSpecificCredentialCreate,
+		Read:   resourceAwsIamServiceSpecificCredentialRead,
+		Update: resourceAwsIamServiceSpecificCredentialUpdate,
+		Delete: resourceAwsIamServiceSpecificCredentialDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"encrypted_service_password": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key_fingerprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"pgp_key": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressIamServiceSpecificCredentialImportedPgpKeyDiff,
+			},
+			"service_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"service_password": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"service_specific_credential_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_user_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  iam.StatusTypeActive,
+				ValidateFunc: validation.StringInSlice([]string{
+					iam.StatusTypeActive,
+					iam.StatusTypeInactive,
+				}, false),
+			},
+			"user_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsIamUserName,
+			},
+		},
+	}
+}
+
+// suppressIamServiceSpecificCredentialImportedPgpKeyDiff suppresses the pgp_key
+// difference of imported credentials, which have no password in state. The
+// password cannot be read back, so replacing them would only rotate it.
+func suppressIamServiceSpecificCredentialImportedPgpKeyDiff(k, old, new string, d *schema.ResourceData) bool {
+	if d.Id() == "" || old != "" {
+		return false
+	}
+
+	return d.Get("encrypted_service_password").(string) == "" && d.Get("service_password").(string) == ""
+}
+
+func resourceAwsIamServiceSpecificCredentialCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	serviceName := d.Get("service_name").(string)
+	userName := d.Get("user_name").(string)
+
+	input := &iam.CreateServiceSpecificCredentialInput{
+		ServiceName: aws.String(serviceName),
+		UserName:    aws.String(userName),
+	}
+
+	log.Printf("[DEBUG] Creating IAM Service Specific Credential: %s", input)
+	output, err := conn.CreateServiceSpecificCredential(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating IAM Service Specific Credential (%s) for IAM User (%s): %s", serviceName, userName, err)
+	}
+
+	if output == nil || output.ServiceSpecificCredential == nil {
+		return fmt.Errorf("error creating IAM Service Specific Credential (%s) for IAM User (%s): empty response", serviceName, userName)
+	}
+
+	credential := output.ServiceSpecificCredential
+	credentialID := aws.StringValue(credential.ServiceSpecificCredentialId)
+
+	d.SetId(fmt.Sprintf("%s:%s:%s", serviceName, userName, credentialID))
+
+	if v, ok := d.GetOk("pgp_key"); ok {
+		encryptionKey, err := encryption.RetrieveGPGKey(strings.TrimSpace(v.(string)))
+		if err != nil {
+			return fmt.Errorf("error retrieving GPG Key during IAM Service Specific Credential (%s) creation: %s", d.Id(), err)
+		}
+
+		fingerprint, encrypted, err := encryption.EncryptValue(encryptionKey, aws.StringValue(credential.ServicePassword), "IAM Service Specific Credential Password")
+		if err != nil {
+			return fmt.Errorf("error encrypting password during IAM Service Specific Credential (%s) creation: %s", d.Id(), err)
+		}
+
+		d.Set("encrypted_service_password", encrypted)
+		d.Set("key_fingerprint", fingerprint)
+	} else {
+		d.Set("service_password", credential.ServicePassword)
+	}
+
+	if v := d.Get("status").(string); v != aws.StringValue(credential.Status) {
+		if err := iamUpdateServiceSpecificCredentialStatus(conn, userName, credentialID, v); err != nil {
+			return fmt.Errorf("error updating IAM Service Specific Credential (%s) status: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsIamServiceSpecificCredentialRead(d, meta)
+}
+
+func resourceAwsIamServiceSpecificCredentialRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	serviceName, userName, credentialID, err := decodeIamServiceSpecificCredentialID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	credential, err := iamDescribeServiceSpecificCredential(conn, serviceName, userName, credentialID)
+
+	if isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+		log.Printf("[WARN] IAM Service Specific Credential (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM Service Specific Credential (%s): %s", d.Id(), err)
+	}
+
+	if credential == nil {
+		log.Printf("[WARN] IAM Service Specific Credential (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("service_name", credential.ServiceName)
+	d.Set("service_specific_credential_id", credential.ServiceSpecificCredentialId)
+	d.Set("service_user_name", credential.ServiceUserName)
+	d.Set("status", credential.Status)
+	d.Set("user_name", credential.UserName)
+
+	return nil
+}
+
+func resourceAwsIamServiceSpecificCredentialUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	if d.HasChange("status") {
+		_, userName, credentialID, err := decodeIamServiceSpecificCredentialID(d.Id())
+
+		if err != nil {
+			return err
+		}
+
+		if err := iamUpdateServiceSpecificCredentialStatus(conn, userName, credentialID, d.Get("status").(string)); err != nil {
+			return fmt.Errorf("error updating IAM Service Specific Credential (%s) status: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsIamServiceSpecificCredentialRead(d, meta)
+}
+
+func resourceAwsIamServiceSpecificCredentialDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	_, userName, credentialID, err := decodeIamServiceSpecificCredentialID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &iam.DeleteServiceSpecificCredentialInput{
+		ServiceSpecificCredentialId: aws.String(credentialID),
+		UserName:                    aws.String(userName),
+	}
+
+	log.Printf("[DEBUG] Deleting IAM Service Specific Credential: %s", input)
+	_, err = conn.DeleteServiceSpecificCredential(input)
+
+	if isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting IAM Service Specific Credential (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func decodeIamServiceSpecificCredentialID(id string) (string, string, string, error) {
+	parts := strings.Split(id, ":")
+
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("unexpected format of ID (%s), expected SERVICE_NAME:USER_NAME:SERVICE_SPECIFIC_CREDENTIAL_ID", id)
+	}
+
+	return parts[0], parts[1], parts[2], nil
+}
+
+func iamDescribeServiceSpecificCredential(conn *iam.IAM, serviceName, userName, credentialID string) (*iam.ServiceSpecificCredentialMetadata, error) {
+	input := &iam.ListServiceSpecificCredentialsInput{
+		ServiceName: aws.String(serviceName),
+		UserName:    aws.String(userName),
+	}
+
+	output, err := conn.ListServiceSpecificCredentials(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	for _, credential := range output.ServiceSpecificCredentials {
+		if aws.StringValue(credential.ServiceSpecificCredentialId) == credentialID {
+			return credential, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func iamUpdateServiceSpecificCredentialStatus(conn *iam.IAM, userName, credentialID, status string) error {
+	input := &iam.UpdateServiceSpecificCredentialInput{
+		ServiceSpecificCredentialId: aws.String(credentialID),
+		Status:                      aws.String(status),
+		UserName:                    aws.String(userName),
+	}
+
+	log.Printf("[DEBUG] Updating IAM Service Specific Credential: %s", input)
+	_, err := conn.UpdateServiceSpecificCredential(input)
+
+	return err
+}
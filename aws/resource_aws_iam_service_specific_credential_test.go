@@ -0,0 +1,293 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/hashicorp/vault/helper/pgpkeys"
+)
+
+func TestAccAWSIAMServiceSpecificCredential_basic(t *testing.T) {
+	var credential iam.ServiceSpecificCredentialMetadata
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_iam_service_specific_credential.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIAMServiceSpecificCredentialDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIAMServiceSpecificCredentialConfig(rName, "Active"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIAMServiceSpecificCredentialExists(resourceName, &credential),
+					resource.TestCheckResourceAttr(resourceName, "encrypted_service_password", ""),
+					resource.TestCheckResourceAttr(resourceName, "service_name", "codecommit.amazonaws.com"),
+					resource.TestCheckResourceAttrSet(resourceName, "service_password"),
+					resource.TestCheckResourceAttrSet(resourceName, "service_specific_credential_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "service_user_name"),
+					resource.TestCheckResourceAttr(resourceName, "status", "Active"),
+					resource.TestCheckResourceAttrPair(resourceName, "user_name", "aws_iam_user.test", "name"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"service_password"},
+			},
+		},
+	})
+}
+
+func TestAccAWSIAMServiceSpecificCredential_disappears(t *testing.T) {
+	var credential iam.ServiceSpecificCredentialMetadata
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_iam_service_specific_credential.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIAMServiceSpecificCredentialDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIAMServiceSpecificCredentialConfig(rName, "Active"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIAMServiceSpecificCredentialExists(resourceName, &credential),
+					testAccCheckAWSIAMServiceSpecificCredentialDisappears(&credential),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSIAMServiceSpecificCredential_Status(t *testing.T) {
+	var credential1, credential2 iam.ServiceSpecificCredentialMetadata
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_iam_service_specific_credential.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIAMServiceSpecificCredentialDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIAMServiceSpecificCredentialConfig(rName, "Inactive"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIAMServiceSpecificCredentialExists(resourceName, &credential1),
+					resource.TestCheckResourceAttr(resourceName, "status", "Inactive"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"service_password"},
+			},
+			{
+				Config: testAccAWSIAMServiceSpecificCredentialConfig(rName, "Active"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIAMServiceSpecificCredentialExists(resourceName, &credential2),
+					testAccCheckAWSIAMServiceSpecificCredentialNotRecreated(&credential1, &credential2),
+					resource.TestCheckResourceAttr(resourceName, "status", "Active"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSIAMServiceSpecificCredential_PgpKey(t *testing.T) {
+	var credential iam.ServiceSpecificCredentialMetadata
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_iam_service_specific_credential.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIAMServiceSpecificCredentialDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIAMServiceSpecificCredentialConfigPgpKey(rName, testPubKey1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIAMServiceSpecificCredentialExists(resourceName, &credential),
+					resource.TestCheckResourceAttrSet(resourceName, "key_fingerprint"),
+					resource.TestCheckResourceAttr(resourceName, "service_password", ""),
+					testAccCheckAWSIAMServiceSpecificCredentialDecryptPassword(resourceName, testPrivKey1),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"encrypted_service_password", "key_fingerprint", "pgp_key"},
+			},
+			// Simulate an imported credential, which has no password
+			// information in state, and verify it is not replaced.
+			{
+				Config: testAccAWSIAMServiceSpecificCredentialConfigPgpKey(rName, testPubKey1),
+				Check:  testAccCheckAWSIAMServiceSpecificCredentialRemovePasswordFromState(resourceName),
+			},
+			{
+				Config:   testAccAWSIAMServiceSpecificCredentialConfigPgpKey(rName, testPubKey1),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSIAMServiceSpecificCredentialRemovePasswordFromState(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		delete(rs.Primary.Attributes, "encrypted_service_password")
+		delete(rs.Primary.Attributes, "key_fingerprint")
+		delete(rs.Primary.Attributes, "pgp_key")
+
+		return nil
+	}
+}
+
+func testAccCheckAWSIAMServiceSpecificCredentialExists(resourceName string, credential *iam.ServiceSpecificCredentialMetadata) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		serviceName, userName, credentialID, err := decodeIamServiceSpecificCredentialID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).iamconn
+
+		output, err := iamDescribeServiceSpecificCredential(conn, serviceName, userName, credentialID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("IAM Service Specific Credential (%s) not found", rs.Primary.ID)
+		}
+
+		*credential = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSIAMServiceSpecificCredentialDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).iamconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iam_service_specific_credential" {
+			continue
+		}
+
+		serviceName, userName, credentialID, err := decodeIamServiceSpecificCredentialID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		output, err := iamDescribeServiceSpecificCredential(conn, serviceName, userName, credentialID)
+
+		if isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil {
+			return fmt.Errorf("IAM Service Specific Credential (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSIAMServiceSpecificCredentialDisappears(credential *iam.ServiceSpecificCredentialMetadata) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).iamconn
+
+		_, err := conn.DeleteServiceSpecificCredential(&iam.DeleteServiceSpecificCredentialInput{
+			ServiceSpecificCredentialId: credential.ServiceSpecificCredentialId,
+			UserName:                    credential.UserName,
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAWSIAMServiceSpecificCredentialNotRecreated(i, j *iam.ServiceSpecificCredentialMetadata) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(i.ServiceSpecificCredentialId) != aws.StringValue(j.ServiceSpecificCredentialId) {
+			return fmt.Errorf("IAM Service Specific Credential was recreated")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSIAMServiceSpecificCredentialDecryptPassword(resourceName, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if _, err := pgpkeys.DecryptBytes(rs.Primary.Attributes["encrypted_service_password"], key); err != nil {
+			return fmt.Errorf("error decrypting password: %s", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSIAMServiceSpecificCredentialConfig(rName, status string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_user" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_service_specific_credential" "test" {
+  service_name = "codecommit.amazonaws.com"
+  status       = %[2]q
+  user_name    = "${aws_iam_user.test.name}"
+}
+`, rName, status)
+}
+
+func testAccAWSIAMServiceSpecificCredentialConfigPgpKey(rName, pgpKey string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_user" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_service_specific_credential" "test" {
+  service_name = "codecommit.amazonaws.com"
+  user_name    = "${aws_iam_user.test.name}"
+
+  pgp_key = <<EOF
+%[2]s
+EOF
+}
+`, rName, pgpKey)
+}
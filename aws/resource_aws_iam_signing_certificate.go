@@ -0,0 +1,211 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsIamSigningCertificate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIamSigningCertificateCreate,
+		Read:   resourceAwsIamSigningCertificateRead,
+		Update: resourceAwsIamSigningCertificateUpdate,
+		Delete: resourceAwsIamSigningCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"certificate_body": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				StateFunc: normalizeCert,
+			},
+			"certificate_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  iam.StatusTypeActive,
+				ValidateFunc: validation.StringInSlice([]string{
+					iam.StatusTypeActive,
+					iam.StatusTypeInactive,
+				}, false),
+			},
+			"user_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsIamUserName,
+			},
+		},
+	}
+}
+
+func resourceAwsIamSigningCertificateCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	userName := d.Get("user_name").(string)
+
+	input := &iam.UploadSigningCertificateInput{
+		CertificateBody: aws.String(d.Get("certificate_body").(string)),
+		UserName:        aws.String(userName),
+	}
+
+	log.Printf("[DEBUG] Uploading IAM Signing Certificate for IAM User (%s)", userName)
+	output, err := conn.UploadSigningCertificate(input)
+
+	if err != nil {
+		return fmt.Errorf("error uploading IAM Signing Certificate for IAM User (%s): %s", userName, err)
+	}
+
+	if output == nil || output.Certificate == nil {
+		return fmt.Errorf("error uploading IAM Signing Certificate for IAM User (%s): empty response", userName)
+	}
+
+	certificateID := aws.StringValue(output.Certificate.CertificateId)
+
+	d.SetId(fmt.Sprintf("%s:%s", certificateID, userName))
+
+	if v := d.Get("status").(string); v != aws.StringValue(output.Certificate.Status) {
+		if err := iamUpdateSigningCertificateStatus(conn, userName, certificateID, v); err != nil {
+			return fmt.Errorf("error updating IAM Signing Certificate (%s) status: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsIamSigningCertificateRead(d, meta)
+}
+
+func resourceAwsIamSigningCertificateRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	certificateID, userName, err := decodeIamSigningCertificateID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	certificate, err := iamDescribeSigningCertificate(conn, userName, certificateID)
+
+	if isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+		log.Printf("[WARN] IAM Signing Certificate (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM Signing Certificate (%s): %s", d.Id(), err)
+	}
+
+	if certificate == nil {
+		log.Printf("[WARN] IAM Signing Certificate (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("certificate_body", normalizeCert(certificate.CertificateBody))
+	d.Set("certificate_id", certificate.CertificateId)
+	d.Set("status", certificate.Status)
+	d.Set("user_name", certificate.UserName)
+
+	return nil
+}
+
+func resourceAwsIamSigningCertificateUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	if d.HasChange("status") {
+		certificateID, userName, err := decodeIamSigningCertificateID(d.Id())
+
+		if err != nil {
+			return err
+		}
+
+		if err := iamUpdateSigningCertificateStatus(conn, userName, certificateID, d.Get("status").(string)); err != nil {
+			return fmt.Errorf("error updating IAM Signing Certificate (%s) status: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsIamSigningCertificateRead(d, meta)
+}
+
+func resourceAwsIamSigningCertificateDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	certificateID, userName, err := decodeIamSigningCertificateID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &iam.DeleteSigningCertificateInput{
+		CertificateId: aws.String(certificateID),
+		UserName:      aws.String(userName),
+	}
+
+	log.Printf("[DEBUG] Deleting IAM Signing Certificate: %s", input)
+	_, err = conn.DeleteSigningCertificate(input)
+
+	if isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting IAM Signing Certificate (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func decodeIamSigningCertificateID(id string) (string, string, error) {
+	parts := strings.Split(id, ":")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected CERTIFICATE_ID:USER_NAME", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func iamDescribeSigningCertificate(conn *iam.IAM, userName, certificateID string) (*iam.SigningCertificate, error) {
+	var result *iam.SigningCertificate
+
+	input := &iam.ListSigningCertificatesInput{
+		UserName: aws.String(userName),
+	}
+
+	err := conn.ListSigningCertificatesPages(input, func(page *iam.ListSigningCertificatesOutput, lastPage bool) bool {
+		for _, certificate := range page.Certificates {
+			if aws.StringValue(certificate.CertificateId) == certificateID {
+				result = certificate
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	return result, err
+}
+
+func iamUpdateSigningCertificateStatus(conn *iam.IAM, userName, certificateID, status string) error {
+	input := &iam.UpdateSigningCertificateInput{
+		CertificateId: aws.String(certificateID),
+		Status:        aws.String(status),
+		UserName:      aws.String(userName),
+	}
+
+	log.Printf("[DEBUG] Updating IAM Signing Certificate: %s", input)
+	_, err := conn.UpdateSigningCertificate(input)
+
+	return err
+}
@@ -0,0 +1,216 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSIAMSigningCertificate_basic(t *testing.T) {
+	var certificate iam.SigningCertificate
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_iam_signing_certificate.test"
+
+	certificateBody, _, err := acctest.RandTLSCert("Terraform Acceptance Test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIAMSigningCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIAMSigningCertificateConfig(rName, certificateBody, "Active"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIAMSigningCertificateExists(resourceName, &certificate),
+					resource.TestCheckResourceAttr(resourceName, "certificate_body", normalizeCert(certificateBody)),
+					resource.TestCheckResourceAttrSet(resourceName, "certificate_id"),
+					resource.TestCheckResourceAttr(resourceName, "status", "Active"),
+					resource.TestCheckResourceAttrPair(resourceName, "user_name", "aws_iam_user.test", "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSIAMSigningCertificate_disappears(t *testing.T) {
+	var certificate iam.SigningCertificate
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_iam_signing_certificate.test"
+
+	certificateBody, _, err := acctest.RandTLSCert("Terraform Acceptance Test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIAMSigningCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIAMSigningCertificateConfig(rName, certificateBody, "Active"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIAMSigningCertificateExists(resourceName, &certificate),
+					testAccCheckAWSIAMSigningCertificateDisappears(&certificate),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSIAMSigningCertificate_Status(t *testing.T) {
+	var certificate1, certificate2 iam.SigningCertificate
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_iam_signing_certificate.test"
+
+	certificateBody, _, err := acctest.RandTLSCert("Terraform Acceptance Test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIAMSigningCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIAMSigningCertificateConfig(rName, certificateBody, "Inactive"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIAMSigningCertificateExists(resourceName, &certificate1),
+					resource.TestCheckResourceAttr(resourceName, "status", "Inactive"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSIAMSigningCertificateConfig(rName, certificateBody, "Active"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIAMSigningCertificateExists(resourceName, &certificate2),
+					testAccCheckAWSIAMSigningCertificateNotRecreated(&certificate1, &certificate2),
+					resource.TestCheckResourceAttr(resourceName, "status", "Active"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSIAMSigningCertificateExists(resourceName string, certificate *iam.SigningCertificate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		certificateID, userName, err := decodeIamSigningCertificateID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).iamconn
+
+		output, err := iamDescribeSigningCertificate(conn, userName, certificateID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("IAM Signing Certificate (%s) not found", rs.Primary.ID)
+		}
+
+		*certificate = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSIAMSigningCertificateDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).iamconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iam_signing_certificate" {
+			continue
+		}
+
+		certificateID, userName, err := decodeIamSigningCertificateID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		output, err := iamDescribeSigningCertificate(conn, userName, certificateID)
+
+		if isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil {
+			return fmt.Errorf("IAM Signing Certificate (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSIAMSigningCertificateDisappears(certificate *iam.SigningCertificate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).iamconn
+
+		_, err := conn.DeleteSigningCertificate(&iam.DeleteSigningCertificateInput{
+			CertificateId: certificate.CertificateId,
+			UserName:      certificate.UserName,
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAWSIAMSigningCertificateNotRecreated(i, j *iam.SigningCertificate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(i.CertificateId) != aws.StringValue(j.CertificateId) {
+			return fmt.Errorf("IAM Signing Certificate was recreated")
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSIAMSigningCertificateConfig(rName, certificateBody, status string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_user" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_signing_certificate" "test" {
+  certificate_body = %[2]q
+  status           = %[3]q
+  user_name        = "${aws_iam_user.test.name}"
+}
+`, rName, certificateBody, status)
+}
@@ -0,0 +1,204 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform/helper/encryption"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsIamVirtualMfaDevice() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIamVirtualMfaDeviceCreate,
+		Read:   resourceAwsIamVirtualMfaDeviceRead,
+		Delete: resourceAwsIamVirtualMfaDeviceDelete,
+		// Import is not supported: the seed cannot be read back, and an imported
+		// device without pgp_key would plan a replacement that deletes the
+		// user's enrolled MFA device.
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"encrypted_base_32_string_seed": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"encrypted_qr_code_png": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key_fingerprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 226),
+					validation.StringMatch(regexp.MustCompile(`^[\w+=,.@-]+$`), "must only contain alphanumeric characters and the following: +=,.@-_"),
+				),
+			},
+			"path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "/",
+				ValidateFunc: validation.StringLenBetween(1, 512),
+			},
+			"pgp_key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsIamVirtualMfaDeviceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	name := d.Get("name").(string)
+
+	encryptionKey, err := encryption.RetrieveGPGKey(strings.TrimSpace(d.Get("pgp_key").(string)))
+	if err != nil {
+		return fmt.Errorf("error retrieving GPG Key during IAM Virtual MFA Device (%s) creation: %s", name, err)
+	}
+
+	input := &iam.CreateVirtualMFADeviceInput{
+		Path:                 aws.String(d.Get("path").(string)),
+		VirtualMFADeviceName: aws.String(name),
+	}
+
+	log.Printf("[DEBUG] Creating IAM Virtual MFA Device: %s", input)
+	output, err := conn.CreateVirtualMFADevice(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating IAM Virtual MFA Device (%s): %s", name, err)
+	}
+
+	if output == nil || output.VirtualMFADevice == nil {
+		return fmt.Errorf("error creating IAM Virtual MFA Device (%s): empty response", name)
+	}
+
+	d.SetId(aws.StringValue(output.VirtualMFADevice.SerialNumber))
+
+	fingerprint, encryptedSeed, err := encryption.EncryptValue(encryptionKey, string(output.VirtualMFADevice.Base32StringSeed), "Base32 String Seed")
+	if err != nil {
+		return fmt.Errorf("error encrypting Base32 string seed during IAM Virtual MFA Device (%s) creation: %s", name, err)
+	}
+
+	_, encryptedQRCodePNG, err := encryption.EncryptValue(encryptionKey, string(output.VirtualMFADevice.QRCodePNG), "QR Code PNG")
+	if err != nil {
+		return fmt.Errorf("error encrypting QR code PNG during IAM Virtual MFA Device (%s) creation: %s", name, err)
+	}
+
+	d.Set("encrypted_base_32_string_seed", encryptedSeed)
+	d.Set("encrypted_qr_code_png", encryptedQRCodePNG)
+	d.Set("key_fingerprint", fingerprint)
+
+	return resourceAwsIamVirtualMfaDeviceRead(d, meta)
+}
+
+func resourceAwsIamVirtualMfaDeviceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	virtualMFADevice, err := iamDescribeVirtualMfaDevice(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM Virtual MFA Device (%s): %s", d.Id(), err)
+	}
+
+	if virtualMFADevice == nil {
+		log.Printf("[WARN] IAM Virtual MFA Device (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	path, name, err := decodeIamVirtualMfaDeviceSerialNumber(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	d.Set("arn", virtualMFADevice.SerialNumber)
+	d.Set("name", name)
+	d.Set("path", path)
+
+	return nil
+}
+
+func resourceAwsIamVirtualMfaDeviceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	input := &iam.DeleteVirtualMFADeviceInput{
+		SerialNumber: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting IAM Virtual MFA Device: %s", input)
+	_, err := conn.DeleteVirtualMFADevice(input)
+
+	if isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting IAM Virtual MFA Device (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func iamDescribeVirtualMfaDevice(conn *iam.IAM, serialNumber string) (*iam.VirtualMFADevice, error) {
+	var result *iam.VirtualMFADevice
+
+	input := &iam.ListVirtualMFADevicesInput{
+		AssignmentStatus: aws.String(iam.AssignmentStatusTypeAny),
+	}
+
+	err := conn.ListVirtualMFADevicesPages(input, func(page *iam.ListVirtualMFADevicesOutput, lastPage bool) bool {
+		for _, virtualMFADevice := range page.VirtualMFADevices {
+			if aws.StringValue(virtualMFADevice.SerialNumber) == serialNumber {
+				result = virtualMFADevice
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	return result, err
+}
+
+// decodeIamVirtualMfaDeviceSerialNumber returns the path and name of a virtual MFA device
+// from its serial number, e.g. arn:aws:iam::123456789012:mfa/path/name.
+func decodeIamVirtualMfaDeviceSerialNumber(serialNumber string) (string, string, error) {
+	parsedARN, err := arn.Parse(serialNumber)
+
+	if err != nil {
+		return "", "", fmt.Errorf("error parsing IAM Virtual MFA Device serial number (%s): %s", serialNumber, err)
+	}
+
+	if !strings.HasPrefix(parsedARN.Resource, "mfa/") {
+		return "", "", fmt.Errorf("unexpected format of IAM Virtual MFA Device serial number (%s), expected arn:PARTITION:iam::ACCOUNT:mfa/PATH/NAME", serialNumber)
+	}
+
+	resource := strings.TrimPrefix(parsedARN.Resource, "mfa")
+	i := strings.LastIndex(resource, "/")
+
+	if i == len(resource)-1 {
+		return "", "", fmt.Errorf("unexpected format of IAM Virtual MFA Device serial number (%s), expected arn:PARTITION:iam::ACCOUNT:mfa/PATH/NAME", serialNumber)
+	}
+
+	return resource[:i+1], resource[i+1:], nil
+}
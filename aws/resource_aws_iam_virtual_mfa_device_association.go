@@ -0,0 +1,164 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsIamVirtualMfaDeviceAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsIamVirtualMfaDeviceAssociationCreate,
+		Read:   resourceAwsIamVirtualMfaDeviceAssociationRead,
+		Delete: resourceAwsIamVirtualMfaDeviceAssociationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"authentication_code_1": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[0-9]{6}$`), "must be a six digit code"),
+			},
+			"authentication_code_2": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[0-9]{6}$`), "must be a six digit code"),
+			},
+			"enable_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"serial_number": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsIamUserName,
+			},
+		},
+	}
+}
+
+func resourceAwsIamVirtualMfaDeviceAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	serialNumber := d.Get("serial_number").(string)
+	user := d.Get("user").(string)
+
+	input := &iam.EnableMFADeviceInput{
+		AuthenticationCode1: aws.String(d.Get("authentication_code_1").(string)),
+		AuthenticationCode2: aws.String(d.Get("authentication_code_2").(string)),
+		SerialNumber:        aws.String(serialNumber),
+		UserName:            aws.String(user),
+	}
+
+	log.Printf("[DEBUG] Enabling IAM Virtual MFA Device (%s) for IAM User (%s)", serialNumber, user)
+	if _, err := conn.EnableMFADevice(input); err != nil {
+		return fmt.Errorf("error enabling IAM Virtual MFA Device (%s) for IAM User (%s): %s", serialNumber, user, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", user, serialNumber))
+
+	return resourceAwsIamVirtualMfaDeviceAssociationRead(d, meta)
+}
+
+func resourceAwsIamVirtualMfaDeviceAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	user, serialNumber, err := decodeIamVirtualMfaDeviceAssociationID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	var mfaDevice *iam.MFADevice
+
+	input := &iam.ListMFADevicesInput{
+		UserName: aws.String(user),
+	}
+
+	err = conn.ListMFADevicesPages(input, func(page *iam.ListMFADevicesOutput, lastPage bool) bool {
+		for _, device := range page.MFADevices {
+			if aws.StringValue(device.SerialNumber) == serialNumber {
+				mfaDevice = device
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+		log.Printf("[WARN] IAM User (%s) not found, removing IAM Virtual MFA Device Association (%s) from state", user, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM Virtual MFA Device Association (%s): %s", d.Id(), err)
+	}
+
+	if mfaDevice == nil {
+		log.Printf("[WARN] IAM Virtual MFA Device Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("enable_date", aws.TimeValue(mfaDevice.EnableDate).Format(time.RFC3339))
+	d.Set("serial_number", mfaDevice.SerialNumber)
+	d.Set("user", mfaDevice.UserName)
+
+	return nil
+}
+
+func resourceAwsIamVirtualMfaDeviceAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).iamconn
+
+	user, serialNumber, err := decodeIamVirtualMfaDeviceAssociationID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &iam.DeactivateMFADeviceInput{
+		SerialNumber: aws.String(serialNumber),
+		UserName:     aws.String(user),
+	}
+
+	log.Printf("[DEBUG] Deactivating IAM Virtual MFA Device (%s) for IAM User (%s)", serialNumber, user)
+	_, err = conn.DeactivateMFADevice(input)
+
+	if isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deactivating IAM Virtual MFA Device (%s) for IAM User (%s): %s", serialNumber, user, err)
+	}
+
+	return nil
+}
+
+// decodeIamVirtualMfaDeviceAssociationID splits the resource identifier at the first colon,
+// as IAM user names cannot contain colons but MFA device serial numbers (ARNs) do.
+func decodeIamVirtualMfaDeviceAssociationID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected USER:SERIAL_NUMBER", id)
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,245 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/pquerna/otp/totp"
+)
+
+func TestDecodeIamVirtualMfaDeviceAssociationID(t *testing.T) {
+	testCases := []struct {
+		ID           string
+		User         string
+		SerialNumber string
+		ErrCount     int
+	}{
+		{
+			ID:       "",
+			ErrCount: 1,
+		},
+		{
+			ID:       "test-user",
+			ErrCount: 1,
+		},
+		{
+			ID:       ":arn:aws:iam::123456789012:mfa/test",
+			ErrCount: 1,
+		},
+		{
+			ID:       "test-user:",
+			ErrCount: 1,
+		},
+		{
+			ID:           "test-user:arn:aws:iam::123456789012:mfa/test",
+			User:         "test-user",
+			SerialNumber: "arn:aws:iam::123456789012:mfa/test",
+		},
+		{
+			ID:           "test-user:GAHT12345678",
+			User:         "test-user",
+			SerialNumber: "GAHT12345678",
+		},
+	}
+
+	for _, tc := range testCases {
+		user, serialNumber, err := decodeIamVirtualMfaDeviceAssociationID(tc.ID)
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("expected %q not to trigger an error, received: %s", tc.ID, err)
+		}
+		if tc.ErrCount > 0 && err == nil {
+			t.Fatalf("expected %q to trigger an error", tc.ID)
+		}
+		if user != tc.User {
+			t.Fatalf("expected %q to return user (%s), received: %s", tc.ID, tc.User, user)
+		}
+		if serialNumber != tc.SerialNumber {
+			t.Fatalf("expected %q to return serial number (%s), received: %s", tc.ID, tc.SerialNumber, serialNumber)
+		}
+	}
+}
+
+func TestAccAWSIAMVirtualMfaDeviceAssociation_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_iam_virtual_mfa_device_association.test"
+
+	serialNumber, authenticationCode1, authenticationCode2 := testAccAWSIAMVirtualMfaDeviceAssociationCreateDevice(t, rName)
+	defer testAccAWSIAMVirtualMfaDeviceAssociationDeleteDevice(t, serialNumber)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIAMVirtualMfaDeviceAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIAMVirtualMfaDeviceAssociationConfig(rName, serialNumber, authenticationCode1, authenticationCode2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIAMVirtualMfaDeviceAssociationExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "enable_date"),
+					resource.TestCheckResourceAttr(resourceName, "serial_number", serialNumber),
+					resource.TestCheckResourceAttrPair(resourceName, "user", "aws_iam_user.test", "name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSIAMVirtualMfaDeviceAssociation_disappears(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_iam_virtual_mfa_device_association.test"
+
+	serialNumber, authenticationCode1, authenticationCode2 := testAccAWSIAMVirtualMfaDeviceAssociationCreateDevice(t, rName)
+	defer testAccAWSIAMVirtualMfaDeviceAssociationDeleteDevice(t, serialNumber)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIAMVirtualMfaDeviceAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIAMVirtualMfaDeviceAssociationConfig(rName, serialNumber, authenticationCode1, authenticationCode2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIAMVirtualMfaDeviceAssociationExists(resourceName),
+					testAccCheckAWSIAMVirtualMfaDeviceAssociationDisappears(resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// testAccAWSIAMVirtualMfaDeviceAssociationCreateDevice creates a virtual MFA device outside
+// of Terraform so that its seed is available to generate the authentication codes.
+func testAccAWSIAMVirtualMfaDeviceAssociationCreateDevice(t *testing.T, rName string) (string, string, string) {
+	if os.Getenv(resource.TestEnvVar) == "" {
+		t.Skipf("Acceptance tests skipped unless env '%s' set", resource.TestEnvVar)
+	}
+
+	testAccPreCheck(t)
+
+	conn := testAccProvider.Meta().(*AWSClient).iamconn
+
+	output, err := conn.CreateVirtualMFADevice(&iam.CreateVirtualMFADeviceInput{
+		VirtualMFADeviceName: aws.String(rName),
+	})
+
+	if err != nil {
+		t.Fatalf("error creating IAM Virtual MFA Device (%s): %s", rName, err)
+	}
+
+	serialNumber := aws.StringValue(output.VirtualMFADevice.SerialNumber)
+	secret := string(output.VirtualMFADevice.Base32StringSeed)
+
+	authenticationCode1, err := totp.GenerateCode(secret, time.Now().Add(-30*time.Second))
+	if err != nil {
+		t.Fatalf("error generating Virtual MFA Device authentication code 1: %s", err)
+	}
+
+	authenticationCode2, err := totp.GenerateCode(secret, time.Now())
+	if err != nil {
+		t.Fatalf("error generating Virtual MFA Device authentication code 2: %s", err)
+	}
+
+	return serialNumber, authenticationCode1, authenticationCode2
+}
+
+func testAccAWSIAMVirtualMfaDeviceAssociationDeleteDevice(t *testing.T, serialNumber string) {
+	conn := testAccProvider.Meta().(*AWSClient).iamconn
+
+	_, err := conn.DeleteVirtualMFADevice(&iam.DeleteVirtualMFADeviceInput{
+		SerialNumber: aws.String(serialNumber),
+	})
+
+	if err != nil && !isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+		t.Errorf("error deleting IAM Virtual MFA Device (%s): %s", serialNumber, err)
+	}
+}
+
+func testAccCheckAWSIAMVirtualMfaDeviceAssociationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).iamconn
+
+		virtualMFADevice, err := iamDescribeVirtualMfaDevice(conn, rs.Primary.Attributes["serial_number"])
+
+		if err != nil {
+			return err
+		}
+
+		if virtualMFADevice == nil || virtualMFADevice.User == nil || aws.StringValue(virtualMFADevice.User.UserName) != rs.Primary.Attributes["user"] {
+			return fmt.Errorf("IAM Virtual MFA Device Association (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSIAMVirtualMfaDeviceAssociationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).iamconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iam_virtual_mfa_device_association" {
+			continue
+		}
+
+		virtualMFADevice, err := iamDescribeVirtualMfaDevice(conn, rs.Primary.Attributes["serial_number"])
+
+		if err != nil {
+			return err
+		}
+
+		if virtualMFADevice != nil && virtualMFADevice.User != nil {
+			return fmt.Errorf("IAM Virtual MFA Device Association (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSIAMVirtualMfaDeviceAssociationDisappears(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).iamconn
+
+		_, err := conn.DeactivateMFADevice(&iam.DeactivateMFADeviceInput{
+			SerialNumber: aws.String(rs.Primary.Attributes["serial_number"]),
+			UserName:     aws.String(rs.Primary.Attributes["user"]),
+		})
+
+		return err
+	}
+}
+
+func testAccAWSIAMVirtualMfaDeviceAssociationConfig(rName, serialNumber, authenticationCode1, authenticationCode2 string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_user" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_virtual_mfa_device_association" "test" {
+  authentication_code_1 = %[3]q
+  authentication_code_2 = %[4]q
+  serial_number         = %[2]q
+  user                  = "${aws_iam_user.test.name}"
+}
+`, rName, serialNumber, authenticationCode1, authenticationCode2)
+}
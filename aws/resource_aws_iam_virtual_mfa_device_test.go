@@ -0,0 +1,240 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/hashicorp/vault/helper/pgpkeys"
+	"github.com/pquerna/otp/totp"
+)
+
+func TestDecodeIamVirtualMfaDeviceSerialNumber(t *testing.T) {
+	testCases := []struct {
+		SerialNumber string
+		Path         string
+		Name         string
+		ErrCount     int
+	}{
+		{
+			SerialNumber: "",
+			ErrCount:     1,
+		},
+		{
+			SerialNumber: "GAHT12345678",
+			ErrCount:     1,
+		},
+		{
+			SerialNumber: "arn:aws:iam::123456789012:user/test",
+			ErrCount:     1,
+		},
+		{
+			SerialNumber: "arn:aws:iam::123456789012:mfa/",
+			ErrCount:     1,
+		},
+		{
+			SerialNumber: "arn:aws:iam::123456789012:mfa/test",
+			Path:         "/",
+			Name:         "test",
+		},
+		{
+			SerialNumber: "arn:aws:iam::123456789012:mfa/path1/path2/test",
+			Path:         "/path1/path2/",
+			Name:         "test",
+		},
+	}
+
+	for _, tc := range testCases {
+		path, name, err := decodeIamVirtualMfaDeviceSerialNumber(tc.SerialNumber)
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("expected %q not to trigger an error, received: %s", tc.SerialNumber, err)
+		}
+		if tc.ErrCount > 0 && err == nil {
+			t.Fatalf("expected %q to trigger an error", tc.SerialNumber)
+		}
+		if path != tc.Path {
+			t.Fatalf("expected %q to return path (%s), received: %s", tc.SerialNumber, tc.Path, path)
+		}
+		if name != tc.Name {
+			t.Fatalf("expected %q to return name (%s), received: %s", tc.SerialNumber, tc.Name, name)
+		}
+	}
+}
+
+func TestAccAWSIAMVirtualMfaDevice_basic(t *testing.T) {
+	var virtualMFADevice iam.VirtualMFADevice
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_iam_virtual_mfa_device.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIAMVirtualMfaDeviceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIAMVirtualMfaDeviceConfig(rName, "/", testPubKey1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIAMVirtualMfaDeviceExists(resourceName, &virtualMFADevice),
+					testAccCheckResourceAttrGlobalARN(resourceName, "arn", "iam", fmt.Sprintf("mfa/%s", rName)),
+					resource.TestCheckResourceAttrSet(resourceName, "encrypted_qr_code_png"),
+					resource.TestCheckResourceAttrSet(resourceName, "key_fingerprint"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "path", "/"),
+					testAccCheckAWSIAMVirtualMfaDeviceDecryptSeed(resourceName, testPrivKey1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSIAMVirtualMfaDevice_disappears(t *testing.T) {
+	var virtualMFADevice iam.VirtualMFADevice
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_iam_virtual_mfa_device.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIAMVirtualMfaDeviceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIAMVirtualMfaDeviceConfig(rName, "/", testPubKey1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIAMVirtualMfaDeviceExists(resourceName, &virtualMFADevice),
+					testAccCheckAWSIAMVirtualMfaDeviceDisappears(&virtualMFADevice),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSIAMVirtualMfaDevice_Path(t *testing.T) {
+	var virtualMFADevice iam.VirtualMFADevice
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_iam_virtual_mfa_device.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSIAMVirtualMfaDeviceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSIAMVirtualMfaDeviceConfig(rName, "/path1/", testPubKey1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSIAMVirtualMfaDeviceExists(resourceName, &virtualMFADevice),
+					testAccCheckResourceAttrGlobalARN(resourceName, "arn", "iam", fmt.Sprintf("mfa/path1/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "path", "/path1/"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSIAMVirtualMfaDeviceExists(resourceName string, virtualMFADevice *iam.VirtualMFADevice) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).iamconn
+
+		output, err := iamDescribeVirtualMfaDevice(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("IAM Virtual MFA Device (%s) not found", rs.Primary.ID)
+		}
+
+		*virtualMFADevice = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSIAMVirtualMfaDeviceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).iamconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_iam_virtual_mfa_device" {
+			continue
+		}
+
+		output, err := iamDescribeVirtualMfaDevice(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil {
+			return fmt.Errorf("IAM Virtual MFA Device (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSIAMVirtualMfaDeviceDisappears(virtualMFADevice *iam.VirtualMFADevice) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).iamconn
+
+		_, err := conn.DeleteVirtualMFADevice(&iam.DeleteVirtualMFADeviceInput{
+			SerialNumber: virtualMFADevice.SerialNumber,
+		})
+
+		return err
+	}
+}
+
+// testAccCheckAWSIAMVirtualMfaDeviceDecryptSeed verifies the encrypted seed can be decrypted
+// and used to generate an authentication code.
+func testAccCheckAWSIAMVirtualMfaDeviceDecryptSeed(resourceName, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		seed, err := pgpkeys.DecryptBytes(rs.Primary.Attributes["encrypted_base_32_string_seed"], key)
+		if err != nil {
+			return fmt.Errorf("error decrypting Base32 string seed: %s", err)
+		}
+
+		code, err := totp.GenerateCode(seed.String(), time.Now())
+		if err != nil {
+			return fmt.Errorf("error generating authentication code: %s", err)
+		}
+
+		if !regexp.MustCompile(`^[0-9]{6}$`).MatchString(code) {
+			return fmt.Errorf("unexpected authentication code: %s", code)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSIAMVirtualMfaDeviceConfig(rName, path, pgpKey string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_virtual_mfa_device" "test" {
+  name = %[1]q
+  path = %[2]q
+
+  pgp_key = <<EOF
+%[3]s
+EOF
+}
+`, rName, path, pgpKey)
+}
@@ -0,0 +1,204 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	kinesisStreamConsumerStatusDeleted = "DELETED"
+)
+
+func resourceAwsKinesisStreamConsumer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsKinesisStreamConsumerCreate,
+		Read:   resourceAwsKinesisStreamConsumerRead,
+		Delete: resourceAwsKinesisStreamConsumerDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"stream_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+		},
+	}
+}
+
+func resourceAwsKinesisStreamConsumerCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisconn
+
+	name := d.Get("name").(string)
+
+	input := &kinesis.RegisterStreamConsumerInput{
+		ConsumerName: aws.String(name),
+		StreamARN:    aws.String(d.Get("stream_arn").(string)),
+	}
+
+	log.Printf("[DEBUG] Registering Kinesis Stream Consumer: %s", input)
+	output, err := conn.RegisterStreamConsumer(input)
+
+	if err != nil {
+		return fmt.Errorf("error registering Kinesis Stream Consumer (%s): %s", name, err)
+	}
+
+	if output == nil || output.Consumer == nil {
+		return fmt.Errorf("error registering Kinesis Stream Consumer (%s): empty response", name)
+	}
+
+	d.SetId(aws.StringValue(output.Consumer.ConsumerARN))
+
+	if err := waitForKinesisStreamConsumerActive(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Kinesis Stream Consumer (%s) to become active: %s", d.Id(), err)
+	}
+
+	return resourceAwsKinesisStreamConsumerRead(d, meta)
+}
+
+func resourceAwsKinesisStreamConsumerRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisconn
+
+	consumer, err := kinesisDescribeStreamConsumer(conn, d.Id())
+
+	if isAWSErr(err, kinesis.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Kinesis Stream Consumer (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Kinesis Stream Consumer (%s): %s", d.Id(), err)
+	}
+
+	if consumer == nil {
+		return fmt.Errorf("error reading Kinesis Stream Consumer (%s): empty response", d.Id())
+	}
+
+	d.Set("arn", consumer.ConsumerARN)
+	d.Set("creation_timestamp", aws.TimeValue(consumer.ConsumerCreationTimestamp).Format(time.RFC3339))
+	d.Set("name", consumer.ConsumerName)
+	d.Set("stream_arn", consumer.StreamARN)
+
+	return nil
+}
+
+func resourceAwsKinesisStreamConsumerDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisconn
+
+	input := &kinesis.DeregisterStreamConsumerInput{
+		ConsumerARN: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deregistering Kinesis Stream Consumer: %s", input)
+	_, err := conn.DeregisterStreamConsumer(input)
+
+	if isAWSErr(err, kinesis.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deregistering Kinesis Stream Consumer (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForKinesisStreamConsumerDeletion(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Kinesis Stream Consumer (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func kinesisDescribeStreamConsumer(conn *kinesis.Kinesis, consumerARN string) (*kinesis.ConsumerDescription, error) {
+	output, err := conn.DescribeStreamConsumer(&kinesis.DescribeStreamConsumerInput{
+		ConsumerARN: aws.String(consumerARN),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.ConsumerDescription, nil
+}
+
+func kinesisStreamConsumerStatusRefreshFunc(conn *kinesis.Kinesis, consumerARN string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		consumer, err := kinesisDescribeStreamConsumer(conn, consumerARN)
+
+		if isAWSErr(err, kinesis.ErrCodeResourceNotFoundException, "") {
+			return "", kinesisStreamConsumerStatusDeleted, nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if consumer == nil {
+			return "", kinesisStreamConsumerStatusDeleted, nil
+		}
+
+		return consumer, aws.StringValue(consumer.ConsumerStatus), nil
+	}
+}
+
+func waitForKinesisStreamConsumerActive(conn *kinesis.Kinesis, consumerARN string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{kinesis.ConsumerStatusCreating},
+		Target:     []string{kinesis.ConsumerStatusActive},
+		Refresh:    kinesisStreamConsumerStatusRefreshFunc(conn, consumerARN),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForKinesisStreamConsumerDeletion(conn *kinesis.Kinesis, consumerARN string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{kinesis.ConsumerStatusActive, kinesis.ConsumerStatusDeleting},
+		Target:     []string{kinesisStreamConsumerStatusDeleted},
+		Refresh:    kinesisStreamConsumerStatusRefreshFunc(conn, consumerARN),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
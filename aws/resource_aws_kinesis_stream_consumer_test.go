@@ -0,0 +1,151 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSKinesisStreamConsumer_basic(t *testing.T) {
+	var consumer kinesis.ConsumerDescription
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_kinesis_stream_consumer.test"
+	streamResourceName := "aws_kinesis_stream.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKinesisStreamConsumerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKinesisStreamConsumerConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKinesisStreamConsumerExists(resourceName, &consumer),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "kinesis", regexp.MustCompile(fmt.Sprintf("stream/%[1]s/consumer/%[1]s:.+", rName))),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_timestamp"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "stream_arn", streamResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSKinesisStreamConsumer_disappears(t *testing.T) {
+	var consumer kinesis.ConsumerDescription
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_kinesis_stream_consumer.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKinesisStreamConsumerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKinesisStreamConsumerConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKinesisStreamConsumerExists(resourceName, &consumer),
+					testAccCheckAWSKinesisStreamConsumerDisappears(&consumer),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSKinesisStreamConsumerExists(resourceName string, consumer *kinesis.ConsumerDescription) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).kinesisconn
+
+		output, err := kinesisDescribeStreamConsumer(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("Kinesis Stream Consumer (%s) not found", rs.Primary.ID)
+		}
+
+		*consumer = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSKinesisStreamConsumerDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).kinesisconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_kinesis_stream_consumer" {
+			continue
+		}
+
+		output, err := kinesisDescribeStreamConsumer(conn, rs.Primary.ID)
+
+		if isAWSErr(err, kinesis.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil {
+			return fmt.Errorf("Kinesis Stream Consumer (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSKinesisStreamConsumerDisappears(consumer *kinesis.ConsumerDescription) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).kinesisconn
+
+		_, err := conn.DeregisterStreamConsumer(&kinesis.DeregisterStreamConsumerInput{
+			ConsumerARN: consumer.ConsumerARN,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		return waitForKinesisStreamConsumerDeletion(conn, aws.StringValue(consumer.ConsumerARN), 5*time.Minute)
+	}
+}
+
+func testAccAWSKinesisStreamConsumerConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kinesis_stream" "test" {
+  name        = %[1]q
+  shard_count = 1
+}
+
+resource "aws_kinesis_stream_consumer" "test" {
+  name       = %[1]q
+  stream_arn = "${aws_kinesis_stream.test.arn}"
+}
+`, rName)
+}
@@ -0,0 +1,313 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsKmsCustomKeyStore() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsKmsCustomKeyStoreCreate,
+		Read:   resourceAwsKmsCustomKeyStoreRead,
+		Update: resourceAwsKmsCustomKeyStoreUpdate,
+		Delete: resourceAwsKmsCustomKeyStoreDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cloud_hsm_cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(19, 24),
+			},
+			"connection_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"custom_key_store_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"key_store_password": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(7, 32),
+			},
+			"trust_anchor_certificate": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return strings.TrimSpace(old) == strings.TrimSpace(new)
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsKmsCustomKeyStoreCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kmsconn
+
+	name := d.Get("custom_key_store_name").(string)
+
+	input := &kms.CreateCustomKeyStoreInput{
+		CloudHsmClusterId:      aws.String(d.Get("cloud_hsm_cluster_id").(string)),
+		CustomKeyStoreName:     aws.String(name),
+		KeyStorePassword:       aws.String(d.Get("key_store_password").(string)),
+		TrustAnchorCertificate: aws.String(d.Get("trust_anchor_certificate").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating KMS Custom Key Store: %s", input)
+	output, err := conn.CreateCustomKeyStore(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating KMS Custom Key Store (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.CustomKeyStoreId))
+
+	if err := connectKmsCustomKeyStore(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	return resourceAwsKmsCustomKeyStoreRead(d, meta)
+}
+
+func resourceAwsKmsCustomKeyStoreRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kmsconn
+
+	customKeyStore, err := kmsDescribeCustomKeyStore(conn, d.Id())
+
+	if isAWSErr(err, kms.ErrCodeCustomKeyStoreNotFoundException, "") {
+		log.Printf("[WARN] KMS Custom Key Store (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading KMS Custom Key Store (%s): %s", d.Id(), err)
+	}
+
+	if customKeyStore == nil {
+		log.Printf("[WARN] KMS Custom Key Store (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("cloud_hsm_cluster_id", customKeyStore.CloudHsmClusterId)
+	d.Set("connection_state", customKeyStore.ConnectionState)
+	d.Set("custom_key_store_name", customKeyStore.CustomKeyStoreName)
+	d.Set("trust_anchor_certificate", customKeyStore.TrustAnchorCertificate)
+
+	return nil
+}
+
+func resourceAwsKmsCustomKeyStoreUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kmsconn
+
+	input := &kms.UpdateCustomKeyStoreInput{
+		CustomKeyStoreId: aws.String(d.Id()),
+	}
+
+	update := false
+
+	if d.HasChange("custom_key_store_name") {
+		input.NewCustomKeyStoreName = aws.String(d.Get("custom_key_store_name").(string))
+		update = true
+	}
+
+	// The cluster and password can only be changed while the custom key store is disconnected.
+	reconnect := false
+
+	if d.HasChange("cloud_hsm_cluster_id") {
+		input.CloudHsmClusterId = aws.String(d.Get("cloud_hsm_cluster_id").(string))
+		reconnect = true
+	}
+
+	// The password cannot be read back, so it is empty in state after import.
+	// Only a change from a previously known password is a real rotation;
+	// otherwise the configured value is just stored.
+	if o, n := d.GetChange("key_store_password"); o.(string) != "" && o.(string) != n.(string) {
+		input.KeyStorePassword = aws.String(n.(string))
+		reconnect = true
+	}
+
+	if !update && !reconnect {
+		return resourceAwsKmsCustomKeyStoreRead(d, meta)
+	}
+
+	if reconnect {
+		if err := disconnectKmsCustomKeyStore(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] Updating KMS Custom Key Store: %s", input)
+	if _, err := conn.UpdateCustomKeyStore(input); err != nil {
+		return fmt.Errorf("error updating KMS Custom Key Store (%s): %s", d.Id(), err)
+	}
+
+	if reconnect {
+		if err := connectKmsCustomKeyStore(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsKmsCustomKeyStoreRead(d, meta)
+}
+
+func resourceAwsKmsCustomKeyStoreDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kmsconn
+
+	if err := disconnectKmsCustomKeyStore(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		if isAWSErr(err, kms.ErrCodeCustomKeyStoreNotFoundException, "") {
+			return nil
+		}
+		return err
+	}
+
+	input := &kms.DeleteCustomKeyStoreInput{
+		CustomKeyStoreId: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting KMS Custom Key Store: %s", input)
+	_, err := conn.DeleteCustomKeyStore(input)
+
+	if isAWSErr(err, kms.ErrCodeCustomKeyStoreNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting KMS Custom Key Store (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func kmsDescribeCustomKeyStore(conn *kms.KMS, id string) (*kms.CustomKeyStoresListEntry, error) {
+	output, err := conn.DescribeCustomKeyStores(&kms.DescribeCustomKeyStoresInput{
+		CustomKeyStoreId: aws.String(id),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	for _, customKeyStore := range output.CustomKeyStores {
+		if customKeyStore == nil {
+			continue
+		}
+
+		if aws.StringValue(customKeyStore.CustomKeyStoreId) == id {
+			return customKeyStore, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func kmsCustomKeyStoreConnectionStateRefreshFunc(conn *kms.KMS, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		customKeyStore, err := kmsDescribeCustomKeyStore(conn, id)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if customKeyStore == nil {
+			return nil, "", fmt.Errorf("KMS Custom Key Store (%s) not found", id)
+		}
+
+		return customKeyStore, aws.StringValue(customKeyStore.ConnectionState), nil
+	}
+}
+
+func connectKmsCustomKeyStore(conn *kms.KMS, id string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Connecting KMS Custom Key Store: %s", id)
+	_, err := conn.ConnectCustomKeyStore(&kms.ConnectCustomKeyStoreInput{
+		CustomKeyStoreId: aws.String(id),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error connecting KMS Custom Key Store (%s): %s", id, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{kms.ConnectionStateTypeConnecting, kms.ConnectionStateTypeDisconnected},
+		Target:     []string{kms.ConnectionStateTypeConnected},
+		Refresh:    kmsCustomKeyStoreConnectionStateRefreshFunc(conn, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if customKeyStore, ok := outputRaw.(*kms.CustomKeyStoresListEntry); ok && aws.StringValue(customKeyStore.ConnectionState) == kms.ConnectionStateTypeFailed {
+		return fmt.Errorf("error connecting KMS Custom Key Store (%s): connection failed: %s", id, aws.StringValue(customKeyStore.ConnectionErrorCode))
+	}
+
+	if err != nil {
+		return fmt.Errorf("error waiting for KMS Custom Key Store (%s) to connect: %s", id, err)
+	}
+
+	return nil
+}
+
+func disconnectKmsCustomKeyStore(conn *kms.KMS, id string, timeout time.Duration) error {
+	customKeyStore, err := kmsDescribeCustomKeyStore(conn, id)
+
+	if err != nil {
+		return err
+	}
+
+	if customKeyStore == nil || aws.StringValue(customKeyStore.ConnectionState) == kms.ConnectionStateTypeDisconnected {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Disconnecting KMS Custom Key Store: %s", id)
+	_, err = conn.DisconnectCustomKeyStore(&kms.DisconnectCustomKeyStoreInput{
+		CustomKeyStoreId: aws.String(id),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error disconnecting KMS Custom Key Store (%s): %s", id, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{kms.ConnectionStateTypeConnected, kms.ConnectionStateTypeDisconnecting, kms.ConnectionStateTypeFailed},
+		Target:     []string{kms.ConnectionStateTypeDisconnected},
+		Refresh:    kmsCustomKeyStoreConnectionStateRefreshFunc(conn, id),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for KMS Custom Key Store (%s) to disconnect: %s", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,175 @@
+package aws
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// A CloudHSM cluster can only back a single custom key store, so these tests must not run in parallel.
+
+func TestAccAWSKmsCustomKeyStore_basic(t *testing.T) {
+	var customKeyStore kms.CustomKeyStoresListEntry
+	clusterID, password, certificate := testAccAWSKmsCustomKeyStoreFromEnv(t)
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_kms_custom_key_store.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKmsCustomKeyStoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKmsCustomKeyStoreConfig(rName, clusterID, password, certificate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsCustomKeyStoreExists(resourceName, &customKeyStore),
+					resource.TestCheckResourceAttr(resourceName, "cloud_hsm_cluster_id", clusterID),
+					resource.TestCheckResourceAttr(resourceName, "connection_state", "CONNECTED"),
+					resource.TestCheckResourceAttr(resourceName, "custom_key_store_name", rName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"key_store_password"},
+			},
+		},
+	})
+}
+
+func TestAccAWSKmsCustomKeyStore_CustomKeyStoreName(t *testing.T) {
+	var customKeyStore kms.CustomKeyStoresListEntry
+	clusterID, password, certificate := testAccAWSKmsCustomKeyStoreFromEnv(t)
+	rName1 := acctest.RandomWithPrefix("tf-acc-test")
+	rName2 := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_kms_custom_key_store.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKmsCustomKeyStoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKmsCustomKeyStoreConfig(rName1, clusterID, password, certificate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsCustomKeyStoreExists(resourceName, &customKeyStore),
+					resource.TestCheckResourceAttr(resourceName, "custom_key_store_name", rName1),
+				),
+			},
+			{
+				Config: testAccAWSKmsCustomKeyStoreConfig(rName2, clusterID, password, certificate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKmsCustomKeyStoreExists(resourceName, &customKeyStore),
+					resource.TestCheckResourceAttr(resourceName, "custom_key_store_name", rName2),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSKmsCustomKeyStoreFromEnv(t *testing.T) (string, string, string) {
+	clusterID := os.Getenv("KMS_CUSTOM_KEY_STORE_CLOUD_HSM_CLUSTER_ID")
+	if clusterID == "" {
+		t.Skip(
+			"Environment variable KMS_CUSTOM_KEY_STORE_CLOUD_HSM_CLUSTER_ID is not set. " +
+				"To properly test KMS custom key stores, the ID of an initialized " +
+				"CloudHSM v2 cluster with at least one active HSM must be provided.")
+	}
+	password := os.Getenv("KMS_CUSTOM_KEY_STORE_PASSWORD")
+	if password == "" {
+		t.Skip(
+			"Environment variable KMS_CUSTOM_KEY_STORE_PASSWORD is not set. " +
+				"To properly test KMS custom key stores, the password of the kmsuser " +
+				"crypto user in the KMS_CUSTOM_KEY_STORE_CLOUD_HSM_CLUSTER_ID cluster must be provided.")
+	}
+	certificatePath := os.Getenv("KMS_CUSTOM_KEY_STORE_TRUST_ANCHOR_CERTIFICATE")
+	if certificatePath == "" {
+		t.Skip(
+			"Environment variable KMS_CUSTOM_KEY_STORE_TRUST_ANCHOR_CERTIFICATE is not set. " +
+				"To properly test KMS custom key stores, the path to the trust anchor certificate " +
+				"(customerCA.crt) of the KMS_CUSTOM_KEY_STORE_CLOUD_HSM_CLUSTER_ID cluster must be provided.")
+	}
+	certificate, err := ioutil.ReadFile(certificatePath)
+	if err != nil {
+		t.Fatalf("error reading trust anchor certificate (%s): %s", certificatePath, err)
+	}
+	return clusterID, password, strings.TrimSpace(string(certificate))
+}
+
+func testAccCheckAWSKmsCustomKeyStoreExists(resourceName string, customKeyStore *kms.CustomKeyStoresListEntry) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).kmsconn
+
+		output, err := kmsDescribeCustomKeyStore(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("KMS Custom Key Store (%s) not found", rs.Primary.ID)
+		}
+
+		*customKeyStore = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSKmsCustomKeyStoreDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).kmsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_kms_custom_key_store" {
+			continue
+		}
+
+		output, err := kmsDescribeCustomKeyStore(conn, rs.Primary.ID)
+
+		if isAWSErr(err, kms.ErrCodeCustomKeyStoreNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil && aws.StringValue(output.CustomKeyStoreId) == rs.Primary.ID {
+			return fmt.Errorf("KMS Custom Key Store (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSKmsCustomKeyStoreConfig(rName, clusterID, password, certificate string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_custom_key_store" "test" {
+  cloud_hsm_cluster_id  = %[2]q
+  custom_key_store_name = %[1]q
+  key_store_password    = %[3]q
+
+  trust_anchor_certificate = <<EOF
+%[4]s
+EOF
+}
+`, rName, clusterID, password, certificate)
+}
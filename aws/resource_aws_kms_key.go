@@ -35,6 +35,12 @@ func resourceAwsKmsKey() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"custom_key_store_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -82,6 +88,10 @@ func resourceAwsKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
 
 	// Allow aws to chose default values if we don't pass them
 	var req kms.CreateKeyInput
+	if v, exists := d.GetOk("custom_key_store_id"); exists {
+		req.CustomKeyStoreId = aws.String(v.(string))
+		req.Origin = aws.String(kms.OriginTypeAwsCloudhsm)
+	}
 	if v, exists := d.GetOk("description"); exists {
 		req.Description = aws.String(v.(string))
 	}
@@ -151,6 +161,7 @@ func resourceAwsKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("arn", metadata.Arn)
 	d.Set("key_id", metadata.KeyId)
+	d.Set("custom_key_store_id", metadata.CustomKeyStoreId)
 	d.Set("description", metadata.Description)
 	d.Set("key_usage", metadata.KeyUsage)
 	d.Set("is_enabled", metadata.Enabled)
@@ -317,6 +317,34 @@ func TestAccAWSLambdaEventSourceMapping_StartingPositionTimestamp(t *testing.T)
 	})
 }
 
+func TestAccAWSLambdaEventSourceMapping_KinesisStreamConsumer(t *testing.T) {
+	var conf lambda.EventSourceMappingConfiguration
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lambda_event_source_mapping.test"
+	consumerResourceName := "aws_kinesis_stream_consumer.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLambdaEventSourceMappingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLambdaEventSourceMappingConfigKinesisStreamConsumer(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsLambdaEventSourceMappingExists(resourceName, &conf),
+					resource.TestCheckResourceAttrPair(resourceName, "event_source_arn", consumerResourceName, "arn"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"starting_position"},
+			},
+		},
+	})
+}
+
 func testAccCheckAWSLambdaEventSourceMappingIsBeingDisabled(conf *lambda.EventSourceMappingConfiguration) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := testAccProvider.Meta().(*AWSClient).lambdaconn
@@ -516,13 +544,16 @@ resource "aws_iam_role_policy" "test" {
           "Action": [
             "kinesis:GetRecords",
             "kinesis:GetShardIterator",
-            "kinesis:DescribeStream"
+            "kinesis:DescribeStream",
+            "kinesis:DescribeStreamSummary",
+            "kinesis:SubscribeToShard"
           ],
           "Resource": "*"
       },
       {
           "Effect": "Allow",
           "Action": [
+            "kinesis:ListShards",
             "kinesis:ListStreams"
           ],
           "Resource": "*"
@@ -547,6 +578,23 @@ resource "aws_lambda_function" "test" {
 `, rName, rName, rName)
 }
 
+func testAccAWSLambdaEventSourceMappingConfigKinesisStreamConsumer(rName string) string {
+	return testAccAWSLambdaEventSourceMappingConfigKinesisBase(rName) + fmt.Sprintf(`
+resource "aws_kinesis_stream_consumer" "test" {
+  name       = %q
+  stream_arn = "${aws_kinesis_stream.test.arn}"
+}
+
+resource "aws_lambda_event_source_mapping" "test" {
+  batch_size        = 100
+  enabled           = true
+  event_source_arn  = "${aws_kinesis_stream_consumer.test.arn}"
+  function_name     = "${aws_lambda_function.test.arn}"
+  starting_position = "LATEST"
+}
+`, rName)
+}
+
 func testAccAWSLambdaEventSourceMappingConfigKinesisStartingPositionTimestamp(rName, startingPositionTimestamp string) string {
 	return testAccAWSLambdaEventSourceMappingConfigKinesisBase(rName) + fmt.Sprintf(`
 resource "aws_lambda_event_source_mapping" "test" {
@@ -0,0 +1,177 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsLightsailDisk() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLightsailDiskCreate,
+		Read:   resourceAwsLightsailDiskRead,
+		Update: resourceAwsLightsailDiskUpdate,
+		Delete: resourceAwsLightsailDiskDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"availability_zone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"size_in_gb": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(8, 16384),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"iops": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"support_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsLightsailDiskCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	name := d.Get("name").(string)
+	input := &lightsail.CreateDiskInput{
+		AvailabilityZone: aws.String(d.Get("availability_zone").(string)),
+		DiskName:         aws.String(name),
+		SizeInGb:         aws.Int64(int64(d.Get("size_in_gb").(int))),
+	}
+
+	if tags := tagsFromMapLightsail(d.Get("tags").(map[string]interface{})); len(tags) > 0 {
+		input.Tags = tags
+	}
+
+	log.Printf("[DEBUG] Creating Lightsail Disk: %s", input)
+	output, err := conn.CreateDisk(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Lightsail Disk (%s): %s", name, err)
+	}
+
+	if len(output.Operations) == 0 {
+		return fmt.Errorf("error creating Lightsail Disk (%s): no operations found", name)
+	}
+
+	d.SetId(name)
+
+	if err := waitForLightsailOperation(output.Operations[0].Id, 10*time.Minute, meta); err != nil {
+		return fmt.Errorf("error waiting for Lightsail Disk (%s) creation: %s", d.Id(), err)
+	}
+
+	return resourceAwsLightsailDiskRead(d, meta)
+}
+
+func resourceAwsLightsailDiskRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	output, err := conn.GetDisk(&lightsail.GetDiskInput{
+		DiskName: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] Lightsail Disk (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Disk (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.Disk == nil {
+		log.Printf("[WARN] Lightsail Disk (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	disk := output.Disk
+
+	d.Set("arn", disk.Arn)
+	if disk.CreatedAt != nil {
+		d.Set("created_at", disk.CreatedAt.Format(time.RFC3339))
+	}
+	if disk.Location != nil {
+		d.Set("availability_zone", disk.Location.AvailabilityZone)
+	}
+	d.Set("iops", disk.Iops)
+	d.Set("name", disk.Name)
+	d.Set("size_in_gb", disk.SizeInGb)
+	d.Set("support_code", disk.SupportCode)
+
+	if err := d.Set("tags", tagsToMapLightsail(disk.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsLightsailDiskUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	if d.HasChange("tags") {
+		if err := setTagsLightsail(conn, d); err != nil {
+			return fmt.Errorf("error updating Lightsail Disk (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsLightsailDiskRead(d, meta)
+}
+
+func resourceAwsLightsailDiskDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	log.Printf("[DEBUG] Deleting Lightsail Disk: %s", d.Id())
+	output, err := conn.DeleteDisk(&lightsail.DeleteDiskInput{
+		DiskName: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Lightsail Disk (%s): %s", d.Id(), err)
+	}
+
+	if len(output.Operations) == 0 {
+		return nil
+	}
+
+	if err := waitForLightsailOperation(output.Operations[0].Id, 10*time.Minute, meta); err != nil {
+		return fmt.Errorf("error waiting for Lightsail Disk (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,212 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsLightsailDiskAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLightsailDiskAttachmentCreate,
+		Read:   resourceAwsLightsailDiskAttachmentRead,
+		Delete: resourceAwsLightsailDiskAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"disk_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"instance_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"disk_path": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsLightsailDiskAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	diskName := d.Get("disk_name").(string)
+	instanceName := d.Get("instance_name").(string)
+	input := &lightsail.AttachDiskInput{
+		DiskName:     aws.String(diskName),
+		DiskPath:     aws.String(d.Get("disk_path").(string)),
+		InstanceName: aws.String(instanceName),
+	}
+
+	log.Printf("[DEBUG] Attaching Lightsail Disk: %s", input)
+	output, err := conn.AttachDisk(input)
+
+	if err != nil {
+		return fmt.Errorf("error attaching Lightsail Disk (%s) to Instance (%s): %s", diskName, instanceName, err)
+	}
+
+	if len(output.Operations) == 0 {
+		return fmt.Errorf("error attaching Lightsail Disk (%s) to Instance (%s): no operations found", diskName, instanceName)
+	}
+
+	d.SetId(fmt.Sprintf("%s,%s", diskName, instanceName))
+
+	if err := waitForLightsailOperation(output.Operations[0].Id, 10*time.Minute, meta); err != nil {
+		return fmt.Errorf("error waiting for Lightsail Disk Attachment (%s) creation: %s", d.Id(), err)
+	}
+
+	return resourceAwsLightsailDiskAttachmentRead(d, meta)
+}
+
+func resourceAwsLightsailDiskAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	diskName, instanceName, err := decodeLightsailAttachmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	output, err := conn.GetDisk(&lightsail.GetDiskInput{
+		DiskName: aws.String(diskName),
+	})
+
+	if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] Lightsail Disk (%s) not found, removing Disk Attachment (%s) from state", diskName, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Disk (%s): %s", diskName, err)
+	}
+
+	if output == nil || output.Disk == nil || !aws.BoolValue(output.Disk.IsAttached) || aws.StringValue(output.Disk.AttachedTo) != instanceName {
+		log.Printf("[WARN] Lightsail Disk Attachment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("disk_name", output.Disk.Name)
+	d.Set("disk_path", output.Disk.Path)
+	d.Set("instance_name", output.Disk.AttachedTo)
+
+	return nil
+}
+
+func resourceAwsLightsailDiskAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	diskName, instanceName, err := decodeLightsailAttachmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	instanceOutput, err := conn.GetInstance(&lightsail.GetInstanceInput{
+		InstanceName: aws.String(instanceName),
+	})
+
+	if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Instance (%s): %s", instanceName, err)
+	}
+
+	var instanceState string
+	if instanceOutput.Instance != nil && instanceOutput.Instance.State != nil {
+		instanceState = aws.StringValue(instanceOutput.Instance.State.Name)
+	}
+
+	// Disks can only be detached from stopped instances
+	if instanceState != "stopped" {
+		log.Printf("[DEBUG] Stopping Lightsail Instance (%s) to detach Disk (%s)", instanceName, diskName)
+		stopOutput, err := conn.StopInstance(&lightsail.StopInstanceInput{
+			InstanceName: aws.String(instanceName),
+		})
+
+		if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error stopping Lightsail Instance (%s): %s", instanceName, err)
+		}
+
+		if len(stopOutput.Operations) > 0 {
+			if err := waitForLightsailOperation(stopOutput.Operations[0].Id, 10*time.Minute, meta); err != nil {
+				return fmt.Errorf("error waiting for Lightsail Instance (%s) to stop: %s", instanceName, err)
+			}
+		}
+	}
+
+	log.Printf("[DEBUG] Detaching Lightsail Disk: %s", d.Id())
+	detachOutput, err := conn.DetachDisk(&lightsail.DetachDiskInput{
+		DiskName: aws.String(diskName),
+	})
+
+	if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error detaching Lightsail Disk (%s) from Instance (%s): %s", diskName, instanceName, err)
+	}
+
+	if len(detachOutput.Operations) > 0 {
+		if err := waitForLightsailOperation(detachOutput.Operations[0].Id, 10*time.Minute, meta); err != nil {
+			return fmt.Errorf("error waiting for Lightsail Disk Attachment (%s) deletion: %s", d.Id(), err)
+		}
+	}
+
+	// Only restart instances that were running before the disk was detached
+	if instanceState != "running" {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Starting Lightsail Instance (%s)", instanceName)
+	startOutput, err := conn.StartInstance(&lightsail.StartInstanceInput{
+		InstanceName: aws.String(instanceName),
+	})
+
+	if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error starting Lightsail Instance (%s): %s", instanceName, err)
+	}
+
+	if len(startOutput.Operations) > 0 {
+		if err := waitForLightsailOperation(startOutput.Operations[0].Id, 10*time.Minute, meta); err != nil {
+			return fmt.Errorf("error waiting for Lightsail Instance (%s) to start: %s", instanceName, err)
+		}
+	}
+
+	return nil
+}
+
+// decodeLightsailAttachmentID splits the ID of a Lightsail attachment resource,
+// which is of the form "<parent name>,<child name>".
+func decodeLightsailAttachmentID(id string) (string, string, error) {
+	parts := strings.Split(id, ",")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected NAME,NAME", id)
+	}
+
+	return parts[0], parts[1], nil
+}
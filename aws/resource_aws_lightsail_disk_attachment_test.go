@@ -0,0 +1,186 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestDecodeLightsailAttachmentID(t *testing.T) {
+	var testCases = []struct {
+		Input         string
+		ExpectedParts []string
+		ErrCount      int
+	}{
+		{
+			Input:    "",
+			ErrCount: 1,
+		},
+		{
+			Input:    "test-disk",
+			ErrCount: 1,
+		},
+		{
+			Input:    "test-disk,",
+			ErrCount: 1,
+		},
+		{
+			Input:    ",test-instance",
+			ErrCount: 1,
+		},
+		{
+			Input:    "test-disk,test-instance,extra",
+			ErrCount: 1,
+		},
+		{
+			Input:         "test-disk,test-instance",
+			ExpectedParts: []string{"test-disk", "test-instance"},
+			ErrCount:      0,
+		},
+	}
+
+	for _, tc := range testCases {
+		parent, child, err := decodeLightsailAttachmentID(tc.Input)
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("expected %q not to trigger an error, received: %s", tc.Input, err)
+		}
+		if tc.ErrCount > 0 && err == nil {
+			t.Fatalf("expected %q to trigger an error", tc.Input)
+		}
+		if tc.ErrCount > 0 {
+			continue
+		}
+		if parent != tc.ExpectedParts[0] || child != tc.ExpectedParts[1] {
+			t.Fatalf("expected parts %v, received: [%s %s]", tc.ExpectedParts, parent, child)
+		}
+	}
+}
+
+func TestAccAWSLightsailDiskAttachment_basic(t *testing.T) {
+	var disk lightsail.Disk
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lightsail_disk_attachment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSLightsail(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLightsailDiskAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLightsailDiskAttachmentConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLightsailDiskAttachmentExists(resourceName, &disk),
+					resource.TestCheckResourceAttrPair(resourceName, "disk_name", "aws_lightsail_disk.test", "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_name", "aws_lightsail_instance.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "disk_path", "/dev/xvdf"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSLightsailDiskAttachmentExists(resourceName string, disk *lightsail.Disk) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lightsail Disk Attachment ID is set")
+		}
+
+		diskName, instanceName, err := decodeLightsailAttachmentID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+
+		output, err := conn.GetDisk(&lightsail.GetDiskInput{
+			DiskName: aws.String(diskName),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || output.Disk == nil || aws.StringValue(output.Disk.AttachedTo) != instanceName {
+			return fmt.Errorf("Lightsail Disk Attachment (%s) not found", rs.Primary.ID)
+		}
+
+		*disk = *output.Disk
+
+		return nil
+	}
+}
+
+func testAccCheckAWSLightsailDiskAttachmentDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lightsail_disk_attachment" {
+			continue
+		}
+
+		diskName, instanceName, err := decodeLightsailAttachmentID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		output, err := conn.GetDisk(&lightsail.GetDiskInput{
+			DiskName: aws.String(diskName),
+		})
+
+		if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil && output.Disk != nil && aws.StringValue(output.Disk.AttachedTo) == instanceName {
+			return fmt.Errorf("Lightsail Disk Attachment (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSLightsailDiskAttachmentConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {
+  state = "available"
+}
+
+resource "aws_lightsail_instance" "test" {
+  name              = %[1]q
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+  blueprint_id      = "amazon_linux"
+  bundle_id         = "nano_1_0"
+}
+
+resource "aws_lightsail_disk" "test" {
+  name              = %[1]q
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+  size_in_gb        = 8
+}
+
+resource "aws_lightsail_disk_attachment" "test" {
+  disk_name     = "${aws_lightsail_disk.test.name}"
+  instance_name = "${aws_lightsail_instance.test.name}"
+  disk_path     = "/dev/xvdf"
+}
+`, rName)
+}
@@ -0,0 +1,241 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSLightsailDisk_basic(t *testing.T) {
+	var disk lightsail.Disk
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lightsail_disk.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSLightsail(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLightsailDiskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLightsailDiskConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLightsailDiskExists(resourceName, &disk),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "availability_zone", "data.aws_availability_zones.available", "names.0"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "size_in_gb", "8"),
+					resource.TestCheckResourceAttrSet(resourceName, "support_code"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSLightsailDisk_Tags(t *testing.T) {
+	var disk lightsail.Disk
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lightsail_disk.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSLightsail(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLightsailDiskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLightsailDiskConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLightsailDiskExists(resourceName, &disk),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSLightsailDiskConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLightsailDiskExists(resourceName, &disk),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSLightsailDiskConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLightsailDiskExists(resourceName, &disk),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSLightsailDisk_disappears(t *testing.T) {
+	var disk lightsail.Disk
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lightsail_disk.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSLightsail(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLightsailDiskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLightsailDiskConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLightsailDiskExists(resourceName, &disk),
+					testAccCheckAWSLightsailDiskDisappears(&disk),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSLightsailDiskExists(resourceName string, disk *lightsail.Disk) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lightsail Disk ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+
+		output, err := conn.GetDisk(&lightsail.GetDiskInput{
+			DiskName: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || output.Disk == nil {
+			return fmt.Errorf("Lightsail Disk (%s) not found", rs.Primary.ID)
+		}
+
+		*disk = *output.Disk
+
+		return nil
+	}
+}
+
+func testAccCheckAWSLightsailDiskDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lightsail_disk" {
+			continue
+		}
+
+		output, err := conn.GetDisk(&lightsail.GetDiskInput{
+			DiskName: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil && output.Disk != nil {
+			return fmt.Errorf("Lightsail Disk (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSLightsailDiskDisappears(disk *lightsail.Disk) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+
+		output, err := conn.DeleteDisk(&lightsail.DeleteDiskInput{
+			DiskName: disk.Name,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if len(output.Operations) == 0 {
+			return nil
+		}
+
+		return waitForLightsailOperation(output.Operations[0].Id, 10*time.Minute, testAccProvider.Meta())
+	}
+}
+
+func testAccAWSLightsailDiskConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {
+  state = "available"
+}
+
+resource "aws_lightsail_disk" "test" {
+  name              = %[1]q
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+  size_in_gb        = 8
+}
+`, rName)
+}
+
+func testAccAWSLightsailDiskConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {
+  state = "available"
+}
+
+resource "aws_lightsail_disk" "test" {
+  name              = %[1]q
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+  size_in_gb        = 8
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSLightsailDiskConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {
+  state = "available"
+}
+
+resource "aws_lightsail_disk" "test" {
+  name              = %[1]q
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+  size_in_gb        = 8
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -14,6 +14,9 @@ func resourceAwsLightsailDomain() *schema.Resource {
 		Create: resourceAwsLightsailDomainCreate,
 		Read:   resourceAwsLightsailDomainRead,
 		Delete: resourceAwsLightsailDomainDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"domain_name": {
@@ -63,6 +66,8 @@ func resourceAwsLightsailDomainRead(d *schema.ResourceData, meta interface{}) er
 	}
 
 	d.Set("arn", resp.Domain.Arn)
+	d.Set("domain_name", resp.Domain.Name)
+
 	return nil
 }
 
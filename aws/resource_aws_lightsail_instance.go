@@ -293,3 +293,20 @@ func resourceAwsLightsailOperationRefreshFunc(
 		return o, *o.Operation.Status, nil
 	}
 }
+
+// waitForLightsailOperation waits for the given Lightsail Operation to
+// complete, returning an error if it fails or the timeout is reached.
+func waitForLightsailOperation(oid *string, timeout time.Duration, meta interface{}) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"NotStarted", "Started"},
+		Target:     []string{"Completed", "Succeeded"},
+		Refresh:    resourceAwsLightsailOperationRefreshFunc(oid, meta),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
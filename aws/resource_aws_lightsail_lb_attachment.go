@@ -0,0 +1,142 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsLightsailLoadBalancerAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLightsailLoadBalancerAttachmentCreate,
+		Read:   resourceAwsLightsailLoadBalancerAttachmentRead,
+		Delete: resourceAwsLightsailLoadBalancerAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"lb_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"instance_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsLightsailLoadBalancerAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	lbName := d.Get("lb_name").(string)
+	instanceName := d.Get("instance_name").(string)
+	input := &lightsail.AttachInstancesToLoadBalancerInput{
+		InstanceNames:    aws.StringSlice([]string{instanceName}),
+		LoadBalancerName: aws.String(lbName),
+	}
+
+	log.Printf("[DEBUG] Attaching Lightsail Instance to Load Balancer: %s", input)
+	output, err := conn.AttachInstancesToLoadBalancer(input)
+
+	if err != nil {
+		return fmt.Errorf("error attaching Lightsail Instance (%s) to Load Balancer (%s): %s", instanceName, lbName, err)
+	}
+
+	if len(output.Operations) == 0 {
+		return fmt.Errorf("error attaching Lightsail Instance (%s) to Load Balancer (%s): no operations found", instanceName, lbName)
+	}
+
+	d.SetId(fmt.Sprintf("%s,%s", lbName, instanceName))
+
+	if err := waitForLightsailOperation(output.Operations[0].Id, 10*time.Minute, meta); err != nil {
+		return fmt.Errorf("error waiting for Lightsail Load Balancer Attachment (%s) creation: %s", d.Id(), err)
+	}
+
+	return resourceAwsLightsailLoadBalancerAttachmentRead(d, meta)
+}
+
+func resourceAwsLightsailLoadBalancerAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	lbName, instanceName, err := decodeLightsailAttachmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	output, err := conn.GetLoadBalancer(&lightsail.GetLoadBalancerInput{
+		LoadBalancerName: aws.String(lbName),
+	})
+
+	if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] Lightsail Load Balancer (%s) not found, removing Attachment (%s) from state", lbName, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Load Balancer (%s): %s", lbName, err)
+	}
+
+	attached := false
+	if output != nil && output.LoadBalancer != nil {
+		for _, summary := range output.LoadBalancer.InstanceHealthSummary {
+			if aws.StringValue(summary.InstanceName) == instanceName {
+				attached = true
+				break
+			}
+		}
+	}
+
+	if !attached {
+		log.Printf("[WARN] Lightsail Load Balancer Attachment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("instance_name", instanceName)
+	d.Set("lb_name", lbName)
+
+	return nil
+}
+
+func resourceAwsLightsailLoadBalancerAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	lbName, instanceName, err := decodeLightsailAttachmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Detaching Lightsail Load Balancer Attachment: %s", d.Id())
+	output, err := conn.DetachInstancesFromLoadBalancer(&lightsail.DetachInstancesFromLoadBalancerInput{
+		InstanceNames:    aws.StringSlice([]string{instanceName}),
+		LoadBalancerName: aws.String(lbName),
+	})
+
+	if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error detaching Lightsail Instance (%s) from Load Balancer (%s): %s", instanceName, lbName, err)
+	}
+
+	if len(output.Operations) == 0 {
+		return nil
+	}
+
+	if err := waitForLightsailOperation(output.Operations[0].Id, 10*time.Minute, meta); err != nil {
+		return fmt.Errorf("error waiting for Lightsail Load Balancer Attachment (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
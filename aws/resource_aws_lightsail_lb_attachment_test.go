@@ -0,0 +1,141 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSLightsailLoadBalancerAttachment_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lightsail_lb_attachment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSLightsail(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLightsailLoadBalancerAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLightsailLoadBalancerAttachmentConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLightsailLoadBalancerAttachmentExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "lb_name", "aws_lightsail_lb.test", "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_name", "aws_lightsail_instance.test", "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSLightsailLoadBalancerAttachmentExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lightsail Load Balancer Attachment ID is set")
+		}
+
+		attached, err := testAccAWSLightsailLoadBalancerAttachmentAttached(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if !attached {
+			return fmt.Errorf("Lightsail Load Balancer Attachment (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSLightsailLoadBalancerAttachmentDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lightsail_lb_attachment" {
+			continue
+		}
+
+		attached, err := testAccAWSLightsailLoadBalancerAttachmentAttached(rs.Primary.ID)
+
+		if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if attached {
+			return fmt.Errorf("Lightsail Load Balancer Attachment (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSLightsailLoadBalancerAttachmentAttached(id string) (bool, error) {
+	lbName, instanceName, err := decodeLightsailAttachmentID(id)
+	if err != nil {
+		return false, err
+	}
+
+	conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+
+	output, err := conn.GetLoadBalancer(&lightsail.GetLoadBalancerInput{
+		LoadBalancerName: aws.String(lbName),
+	})
+
+	if err != nil {
+		return false, err
+	}
+
+	if output == nil || output.LoadBalancer == nil {
+		return false, nil
+	}
+
+	for _, summary := range output.LoadBalancer.InstanceHealthSummary {
+		if aws.StringValue(summary.InstanceName) == instanceName {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func testAccAWSLightsailLoadBalancerAttachmentConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {
+  state = "available"
+}
+
+resource "aws_lightsail_lb" "test" {
+  name          = %[1]q
+  instance_port = 80
+}
+
+resource "aws_lightsail_instance" "test" {
+  name              = %[1]q
+  availability_zone = "${data.aws_availability_zones.available.names[0]}"
+  blueprint_id      = "amazon_linux"
+  bundle_id         = "nano_1_0"
+}
+
+resource "aws_lightsail_lb_attachment" "test" {
+  lb_name       = "${aws_lightsail_lb.test.name}"
+  instance_name = "${aws_lightsail_instance.test.name}"
+}
+`, rName)
+}
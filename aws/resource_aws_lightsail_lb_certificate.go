@@ -0,0 +1,240 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsLightsailLoadBalancerCertificate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLightsailLoadBalancerCertificateCreate,
+		Read:   resourceAwsLightsailLoadBalancerCertificateRead,
+		Delete: resourceAwsLightsailLoadBalancerCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"lb_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"domain_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"subject_alternative_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain_validation_records": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"domain_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_record_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_record_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_record_value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsLightsailLoadBalancerCertificateCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	lbName := d.Get("lb_name").(string)
+	name := d.Get("name").(string)
+	input := &lightsail.CreateLoadBalancerTlsCertificateInput{
+		CertificateDomainName: aws.String(d.Get("domain_name").(string)),
+		CertificateName:       aws.String(name),
+		LoadBalancerName:      aws.String(lbName),
+	}
+
+	if v, ok := d.GetOk("subject_alternative_names"); ok && v.(*schema.Set).Len() > 0 {
+		input.CertificateAlternativeNames = expandStringSet(v.(*schema.Set))
+	}
+
+	log.Printf("[DEBUG] Creating Lightsail Load Balancer Certificate: %s", input)
+	output, err := conn.CreateLoadBalancerTlsCertificate(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Lightsail Load Balancer (%s) Certificate (%s): %s", lbName, name, err)
+	}
+
+	if len(output.Operations) == 0 {
+		return fmt.Errorf("error creating Lightsail Load Balancer (%s) Certificate (%s): no operations found", lbName, name)
+	}
+
+	d.SetId(fmt.Sprintf("%s,%s", lbName, name))
+
+	if err := waitForLightsailOperation(output.Operations[0].Id, 10*time.Minute, meta); err != nil {
+		return fmt.Errorf("error waiting for Lightsail Load Balancer Certificate (%s) creation: %s", d.Id(), err)
+	}
+
+	return resourceAwsLightsailLoadBalancerCertificateRead(d, meta)
+}
+
+func resourceAwsLightsailLoadBalancerCertificateRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	lbName, name, err := decodeLightsailAttachmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	certificate, err := lightsailDescribeLoadBalancerCertificate(conn, lbName, name)
+
+	if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] Lightsail Load Balancer (%s) not found, removing Certificate (%s) from state", lbName, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Load Balancer Certificate (%s): %s", d.Id(), err)
+	}
+
+	if certificate == nil {
+		log.Printf("[WARN] Lightsail Load Balancer Certificate (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", certificate.Arn)
+	if certificate.CreatedAt != nil {
+		d.Set("created_at", certificate.CreatedAt.Format(time.RFC3339))
+	}
+	d.Set("domain_name", certificate.DomainName)
+	d.Set("lb_name", certificate.LoadBalancerName)
+	d.Set("name", certificate.Name)
+	d.Set("status", certificate.Status)
+
+	if err := d.Set("domain_validation_records", flattenLightsailLoadBalancerCertificateDomainValidationRecords(certificate.DomainValidationRecords)); err != nil {
+		return fmt.Errorf("error setting domain_validation_records: %s", err)
+	}
+
+	if err := d.Set("subject_alternative_names", aws.StringValueSlice(certificate.SubjectAlternativeNames)); err != nil {
+		return fmt.Errorf("error setting subject_alternative_names: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsLightsailLoadBalancerCertificateDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	lbName, name, err := decodeLightsailAttachmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Lightsail Load Balancer Certificate: %s", d.Id())
+	output, err := conn.DeleteLoadBalancerTlsCertificate(&lightsail.DeleteLoadBalancerTlsCertificateInput{
+		CertificateName:  aws.String(name),
+		LoadBalancerName: aws.String(lbName),
+	})
+
+	if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Lightsail Load Balancer Certificate (%s): %s", d.Id(), err)
+	}
+
+	if len(output.Operations) == 0 {
+		return nil
+	}
+
+	if err := waitForLightsailOperation(output.Operations[0].Id, 10*time.Minute, meta); err != nil {
+		return fmt.Errorf("error waiting for Lightsail Load Balancer Certificate (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// lightsailDescribeLoadBalancerCertificate returns the named TLS certificate
+// of a Lightsail Load Balancer, or nil if it does not exist.
+func lightsailDescribeLoadBalancerCertificate(conn *lightsail.Lightsail, lbName, name string) (*lightsail.LoadBalancerTlsCertificate, error) {
+	output, err := conn.GetLoadBalancerTlsCertificates(&lightsail.GetLoadBalancerTlsCertificatesInput{
+		LoadBalancerName: aws.String(lbName),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	for _, certificate := range output.TlsCertificates {
+		if aws.StringValue(certificate.Name) == name {
+			return certificate, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func flattenLightsailLoadBalancerCertificateDomainValidationRecords(records []*lightsail.LoadBalancerTlsCertificateDomainValidationRecord) []interface{} {
+	result := make([]interface{}, 0, len(records))
+
+	for _, record := range records {
+		if record == nil {
+			continue
+		}
+
+		result = append(result, map[string]interface{}{
+			"domain_name":           aws.StringValue(record.DomainName),
+			"resource_record_name":  aws.StringValue(record.Name),
+			"resource_record_type":  aws.StringValue(record.Type),
+			"resource_record_value": aws.StringValue(record.Value),
+		})
+	}
+
+	return result
+}
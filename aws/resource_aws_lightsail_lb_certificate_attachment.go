@@ -0,0 +1,141 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsLightsailLoadBalancerCertificateAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLightsailLoadBalancerCertificateAttachmentCreate,
+		Read:   resourceAwsLightsailLoadBalancerCertificateAttachmentRead,
+		Delete: resourceAwsLightsailLoadBalancerCertificateAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(45 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"lb_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"certificate_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsLightsailLoadBalancerCertificateAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	lbName := d.Get("lb_name").(string)
+	certificateName := d.Get("certificate_name").(string)
+
+	// Only validated certificates can be attached
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{lightsail.LoadBalancerTlsCertificateStatusPendingValidation},
+		Target:     []string{lightsail.LoadBalancerTlsCertificateStatusIssued},
+		Refresh:    lightsailLoadBalancerCertificateStatusRefreshFunc(conn, lbName, certificateName),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      5 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for Lightsail Load Balancer (%s) Certificate (%s) validation: %s", lbName, certificateName, err)
+	}
+
+	input := &lightsail.AttachLoadBalancerTlsCertificateInput{
+		CertificateName:  aws.String(certificateName),
+		LoadBalancerName: aws.String(lbName),
+	}
+
+	log.Printf("[DEBUG] Attaching Lightsail Load Balancer Certificate: %s", input)
+	output, err := conn.AttachLoadBalancerTlsCertificate(input)
+
+	if err != nil {
+		return fmt.Errorf("error attaching Lightsail Load Balancer (%s) Certificate (%s): %s", lbName, certificateName, err)
+	}
+
+	if len(output.Operations) == 0 {
+		return fmt.Errorf("error attaching Lightsail Load Balancer (%s) Certificate (%s): no operations found", lbName, certificateName)
+	}
+
+	d.SetId(fmt.Sprintf("%s,%s", lbName, certificateName))
+
+	if err := waitForLightsailOperation(output.Operations[0].Id, 10*time.Minute, meta); err != nil {
+		return fmt.Errorf("error waiting for Lightsail Load Balancer Certificate Attachment (%s) creation: %s", d.Id(), err)
+	}
+
+	return resourceAwsLightsailLoadBalancerCertificateAttachmentRead(d, meta)
+}
+
+func resourceAwsLightsailLoadBalancerCertificateAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lightsailconn
+
+	lbName, certificateName, err := decodeLightsailAttachmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	certificate, err := lightsailDescribeLoadBalancerCertificate(conn, lbName, certificateName)
+
+	if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] Lightsail Load Balancer (%s) not found, removing Certificate Attachment (%s) from state", lbName, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lightsail Load Balancer Certificate (%s): %s", d.Id(), err)
+	}
+
+	if certificate == nil || !aws.BoolValue(certificate.IsAttached) {
+		log.Printf("[WARN] Lightsail Load Balancer Certificate Attachment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("certificate_name", certificate.Name)
+	d.Set("lb_name", certificate.LoadBalancerName)
+
+	return nil
+}
+
+func resourceAwsLightsailLoadBalancerCertificateAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	// There is no API to detach a certificate from a Lightsail Load Balancer;
+	// it stays attached until another certificate is attached or it is deleted.
+	log.Printf("[WARN] Cannot detach Lightsail Load Balancer Certificate (%s), removing from state only", d.Id())
+
+	return nil
+}
+
+func lightsailLoadBalancerCertificateStatusRefreshFunc(conn *lightsail.Lightsail, lbName, certificateName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		certificate, err := lightsailDescribeLoadBalancerCertificate(conn, lbName, certificateName)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if certificate == nil {
+			return nil, "", fmt.Errorf("Lightsail Load Balancer (%s) Certificate (%s) not found", lbName, certificateName)
+		}
+
+		return certificate, aws.StringValue(certificate.Status), nil
+	}
+}
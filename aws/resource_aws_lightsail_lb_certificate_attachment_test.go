@@ -0,0 +1,119 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSLightsailLoadBalancerCertificateAttachment_basic(t *testing.T) {
+	rootDomain := testAccAWSLightsailLoadBalancerCertificateAttachmentRootDomainFromEnv(t)
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	domain := fmt.Sprintf("%s.%s", rName, rootDomain)
+	resourceName := "aws_lightsail_lb_certificate_attachment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPreCheckAWSLightsail(t) },
+		Providers: testAccProviders,
+		// Certificates cannot be detached, so destroying the attachment
+		// leaves the certificate attached until the load balancer is deleted.
+		CheckDestroy: testAccCheckAWSLightsailLoadBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLightsailLoadBalancerCertificateAttachmentConfig(rName, rootDomain, domain),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLightsailLoadBalancerCertificateAttachmentExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "lb_name", "aws_lightsail_lb.test", "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "certificate_name", "aws_lightsail_lb_certificate.test", "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAWSLightsailLoadBalancerCertificateAttachmentRootDomainFromEnv(t *testing.T) string {
+	rootDomain := os.Getenv("LIGHTSAIL_LB_CERTIFICATE_ROOT_DOMAIN")
+	if rootDomain == "" {
+		t.Skip(
+			"Environment variable LIGHTSAIL_LB_CERTIFICATE_ROOT_DOMAIN is not set. " +
+				"Attaching a certificate requires it to be validated through a public Route 53 hosted zone for this domain.")
+	}
+	return rootDomain
+}
+
+func testAccCheckAWSLightsailLoadBalancerCertificateAttachmentExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lightsail Load Balancer Certificate Attachment ID is set")
+		}
+
+		lbName, certificateName, err := decodeLightsailAttachmentID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+
+		certificate, err := lightsailDescribeLoadBalancerCertificate(conn, lbName, certificateName)
+
+		if err != nil {
+			return err
+		}
+
+		if certificate == nil || !aws.BoolValue(certificate.IsAttached) {
+			return fmt.Errorf("Lightsail Load Balancer Certificate Attachment (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSLightsailLoadBalancerCertificateAttachmentConfig(rName, rootDomain, domain string) string {
+	return fmt.Sprintf(`
+data "aws_route53_zone" "test" {
+  name         = "%[2]s."
+  private_zone = false
+}
+
+resource "aws_lightsail_lb" "test" {
+  name          = %[1]q
+  instance_port = 80
+}
+
+resource "aws_lightsail_lb_certificate" "test" {
+  lb_name     = "${aws_lightsail_lb.test.name}"
+  name        = %[1]q
+  domain_name = %[3]q
+}
+
+resource "aws_route53_record" "test" {
+  name    = "${aws_lightsail_lb_certificate.test.domain_validation_records.0.resource_record_name}"
+  type    = "${aws_lightsail_lb_certificate.test.domain_validation_records.0.resource_record_type}"
+  zone_id = "${data.aws_route53_zone.test.zone_id}"
+  records = ["${aws_lightsail_lb_certificate.test.domain_validation_records.0.resource_record_value}"]
+  ttl     = 60
+}
+
+resource "aws_lightsail_lb_certificate_attachment" "test" {
+  depends_on = ["aws_route53_record.test"]
+
+  lb_name          = "${aws_lightsail_lb.test.name}"
+  certificate_name = "${aws_lightsail_lb_certificate.test.name}"
+}
+`, rName, rootDomain, domain)
+}
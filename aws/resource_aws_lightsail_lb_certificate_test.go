@@ -0,0 +1,125 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSLightsailLoadBalancerCertificate_basic(t *testing.T) {
+	var certificate lightsail.LoadBalancerTlsCertificate
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	domainName := fmt.Sprintf("%s.example.com", rName)
+	resourceName := "aws_lightsail_lb_certificate.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSLightsail(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLightsailLoadBalancerCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLightsailLoadBalancerCertificateConfig(rName, domainName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLightsailLoadBalancerCertificateExists(resourceName, &certificate),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttr(resourceName, "domain_name", domainName),
+					resource.TestCheckResourceAttr(resourceName, "domain_validation_records.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "lb_name", "aws_lightsail_lb.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "status", lightsail.LoadBalancerTlsCertificateStatusPendingValidation),
+					resource.TestCheckResourceAttr(resourceName, "subject_alternative_names.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSLightsailLoadBalancerCertificateExists(resourceName string, certificate *lightsail.LoadBalancerTlsCertificate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lightsail Load Balancer Certificate ID is set")
+		}
+
+		lbName, name, err := decodeLightsailAttachmentID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+
+		output, err := lightsailDescribeLoadBalancerCertificate(conn, lbName, name)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("Lightsail Load Balancer Certificate (%s) not found", rs.Primary.ID)
+		}
+
+		*certificate = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSLightsailLoadBalancerCertificateDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lightsail_lb_certificate" {
+			continue
+		}
+
+		lbName, name, err := decodeLightsailAttachmentID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		output, err := lightsailDescribeLoadBalancerCertificate(conn, lbName, name)
+
+		if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil {
+			return fmt.Errorf("Lightsail Load Balancer Certificate (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSLightsailLoadBalancerCertificateConfig(rName, domainName string) string {
+	return fmt.Sprintf(`
+resource "aws_lightsail_lb" "test" {
+  name          = %[1]q
+  instance_port = 80
+}
+
+resource "aws_lightsail_lb_certificate" "test" {
+  lb_name     = "${aws_lightsail_lb.test.name}"
+  name        = %[1]q
+  domain_name = %[2]q
+}
+`, rName, domainName)
+}
@@ -0,0 +1,272 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSLightsailLoadBalancer_basic(t *testing.T) {
+	var lb lightsail.LoadBalancer
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lightsail_lb.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSLightsail(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLightsailLoadBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLightsailLoadBalancerConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLightsailLoadBalancerExists(resourceName, &lb),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+					resource.TestCheckResourceAttrSet(resourceName, "dns_name"),
+					resource.TestCheckResourceAttr(resourceName, "health_check_path", "/"),
+					resource.TestCheckResourceAttr(resourceName, "instance_port", "80"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "protocol", "HTTP"),
+					resource.TestCheckResourceAttr(resourceName, "public_ports.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "public_ports.0", "80"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSLightsailLoadBalancer_HealthCheckPath(t *testing.T) {
+	var lb lightsail.LoadBalancer
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lightsail_lb.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSLightsail(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLightsailLoadBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLightsailLoadBalancerConfigHealthCheckPath(rName, "/healthcheck"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLightsailLoadBalancerExists(resourceName, &lb),
+					resource.TestCheckResourceAttr(resourceName, "health_check_path", "/healthcheck"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSLightsailLoadBalancerConfigHealthCheckPath(rName, "/status"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLightsailLoadBalancerExists(resourceName, &lb),
+					resource.TestCheckResourceAttr(resourceName, "health_check_path", "/status"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSLightsailLoadBalancer_Tags(t *testing.T) {
+	var lb lightsail.LoadBalancer
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lightsail_lb.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSLightsail(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLightsailLoadBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLightsailLoadBalancerConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLightsailLoadBalancerExists(resourceName, &lb),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSLightsailLoadBalancerConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLightsailLoadBalancerExists(resourceName, &lb),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSLightsailLoadBalancerConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSLightsailLoadBalancerExists(resourceName, &lb),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSLightsailLoadBalancer_disappears(t *testing.T) {
+	var lb lightsail.LoadBalancer
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_lightsail_lb.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSLightsail(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLightsailLoadBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLightsailLoadBalancerConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLightsailLoadBalancerExists(resourceName, &lb),
+					testAccCheckAWSLightsailLoadBalancerDisappears(&lb),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSLightsailLoadBalancerExists(resourceName string, lb *lightsail.LoadBalancer) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lightsail Load Balancer ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+
+		output, err := conn.GetLoadBalancer(&lightsail.GetLoadBalancerInput{
+			LoadBalancerName: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || output.LoadBalancer == nil {
+			return fmt.Errorf("Lightsail Load Balancer (%s) not found", rs.Primary.ID)
+		}
+
+		*lb = *output.LoadBalancer
+
+		return nil
+	}
+}
+
+func testAccCheckAWSLightsailLoadBalancerDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lightsail_lb" {
+			continue
+		}
+
+		output, err := conn.GetLoadBalancer(&lightsail.GetLoadBalancerInput{
+			LoadBalancerName: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, lightsail.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil && output.LoadBalancer != nil {
+			return fmt.Errorf("Lightsail Load Balancer (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSLightsailLoadBalancerDisappears(lb *lightsail.LoadBalancer) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).lightsailconn
+
+		output, err := conn.DeleteLoadBalancer(&lightsail.DeleteLoadBalancerInput{
+			LoadBalancerName: lb.Name,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if len(output.Operations) == 0 {
+			return nil
+		}
+
+		return waitForLightsailOperation(output.Operations[0].Id, 10*time.Minute, testAccProvider.Meta())
+	}
+}
+
+func testAccAWSLightsailLoadBalancerConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_lightsail_lb" "test" {
+  name          = %[1]q
+  instance_port = 80
+}
+`, rName)
+}
+
+func testAccAWSLightsailLoadBalancerConfigHealthCheckPath(rName, healthCheckPath string) string {
+	return fmt.Sprintf(`
+resource "aws_lightsail_lb" "test" {
+  name              = %[1]q
+  instance_port     = 80
+  health_check_path = %[2]q
+}
+`, rName, healthCheckPath)
+}
+
+func testAccAWSLightsailLoadBalancerConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_lightsail_lb" "test" {
+  name          = %[1]q
+  instance_port = 80
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSLightsailLoadBalancerConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_lightsail_lb" "test" {
+  name          = %[1]q
+  instance_port = 80
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,925 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsPinpointCampaign() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsPinpointCampaignCreate,
+		Read:   resourceAwsPinpointCampaignRead,
+		Update: resourceAwsPinpointCampaignUpdate,
+		Delete: resourceAwsPinpointCampaignDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsPinpointCampaignImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"segment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"message_configuration": pinpointCampaignMessageConfigurationSchema(true),
+			"schedule":              pinpointCampaignScheduleSchema(true),
+			"additional_treatment": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"message_configuration": pinpointCampaignMessageConfigurationSchema(false),
+						"schedule":              pinpointCampaignScheduleSchema(false),
+						"size_percent": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+						"treatment_description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"treatment_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"holdout_percent": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+			"hook": {
+				Type:             schema.TypeList,
+				Optional:         true,
+				MaxItems:         1,
+				DiffSuppressFunc: suppressMissingOptionalConfigurationBlock,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"lambda_function_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"mode": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								pinpoint.ModeDelivery,
+								pinpoint.ModeFilter,
+							}, false),
+						},
+						"web_url": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"is_paused": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"limits": {
+				Type:             schema.TypeList,
+				Optional:         true,
+				MaxItems:         1,
+				DiffSuppressFunc: suppressMissingOptionalConfigurationBlock,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"daily": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"maximum_duration": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"messages_per_second": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"total": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"segment_version": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"treatment_description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"treatment_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"campaign_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_modified_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsPinpointCampaignCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).pinpointconn
+
+	request := expandPinpointWriteCampaignRequest(d)
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		request.Tags = tagsFromMapGeneric(v)
+	}
+
+	log.Printf("[DEBUG] Creating Pinpoint Campaign: %s", request)
+	output, err := conn.CreateCampaign(&pinpoint.CreateCampaignInput{
+		ApplicationId:        aws.String(d.Get("application_id").(string)),
+		WriteCampaignRequest: request,
+	})
+
+	if err != nil {
+		return fmt.Errorf("error creating Pinpoint Campaign (%s): %s", d.Get("name").(string), err)
+	}
+
+	d.SetId(aws.StringValue(output.CampaignResponse.Id))
+
+	return resourceAwsPinpointCampaignRead(d, meta)
+}
+
+func resourceAwsPinpointCampaignRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).pinpointconn
+
+	output, err := conn.GetCampaign(&pinpoint.GetCampaignInput{
+		ApplicationId: aws.String(d.Get("application_id").(string)),
+		CampaignId:    aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, pinpoint.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] Pinpoint Campaign (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Pinpoint Campaign (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.CampaignResponse == nil {
+		log.Printf("[WARN] Pinpoint Campaign (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	campaign := output.CampaignResponse
+
+	d.Set("application_id", campaign.ApplicationId)
+	d.Set("arn", campaign.Arn)
+	d.Set("creation_date", campaign.CreationDate)
+	d.Set("description", campaign.Description)
+	d.Set("holdout_percent", campaign.HoldoutPercent)
+	d.Set("is_paused", campaign.IsPaused)
+	d.Set("last_modified_date", campaign.LastModifiedDate)
+	d.Set("name", campaign.Name)
+	d.Set("segment_id", campaign.SegmentId)
+	// Campaigns without a configured segment version follow the latest
+	// version of their segment, so only track the version when it is pinned.
+	if _, ok := d.GetOk("segment_version"); ok {
+		d.Set("segment_version", campaign.SegmentVersion)
+	}
+	d.Set("treatment_description", campaign.TreatmentDescription)
+	d.Set("treatment_name", campaign.TreatmentName)
+	d.Set("version", campaign.Version)
+
+	if campaign.State != nil {
+		d.Set("campaign_status", campaign.State.CampaignStatus)
+	}
+
+	if err := d.Set("additional_treatment", flattenPinpointCampaignTreatments(campaign.AdditionalTreatments)); err != nil {
+		return fmt.Errorf("error setting additional_treatment: %s", err)
+	}
+
+	hook := []interface{}{}
+	if campaign.Hook != nil {
+		hook = flattenPinpointCampaignHook(campaign.Hook)
+	}
+	if err := d.Set("hook", hook); err != nil {
+		return fmt.Errorf("error setting hook: %s", err)
+	}
+
+	limits := []interface{}{}
+	if campaign.Limits != nil {
+		limits = flattenPinpointCampaignLimits(campaign.Limits)
+	}
+	if err := d.Set("limits", limits); err != nil {
+		return fmt.Errorf("error setting limits: %s", err)
+	}
+
+	if err := d.Set("message_configuration", flattenPinpointCampaignMessageConfiguration(campaign.MessageConfiguration)); err != nil {
+		return fmt.Errorf("error setting message_configuration: %s", err)
+	}
+
+	if err := d.Set("schedule", flattenPinpointCampaignSchedule(campaign.Schedule)); err != nil {
+		return fmt.Errorf("error setting schedule: %s", err)
+	}
+
+	if err := d.Set("tags", tagsToMapGeneric(campaign.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsPinpointCampaignUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).pinpointconn
+
+	if d.HasChange("additional_treatment") ||
+		d.HasChange("description") ||
+		d.HasChange("holdout_percent") ||
+		d.HasChange("hook") ||
+		d.HasChange("is_paused") ||
+		d.HasChange("limits") ||
+		d.HasChange("message_configuration") ||
+		d.HasChange("name") ||
+		d.HasChange("schedule") ||
+		d.HasChange("segment_id") ||
+		d.HasChange("segment_version") ||
+		d.HasChange("treatment_description") ||
+		d.HasChange("treatment_name") {
+		request := expandPinpointWriteCampaignRequest(d)
+
+		log.Printf("[DEBUG] Updating Pinpoint Campaign (%s): %s", d.Id(), request)
+		_, err := conn.UpdateCampaign(&pinpoint.UpdateCampaignInput{
+			ApplicationId:        aws.String(d.Get("application_id").(string)),
+			CampaignId:           aws.String(d.Id()),
+			WriteCampaignRequest: request,
+		})
+
+		if err != nil {
+			return fmt.Errorf("error updating Pinpoint Campaign (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		if err := setTagsPinpoint(conn, d, d.Get("arn").(string)); err != nil {
+			return fmt.Errorf("error updating Pinpoint Campaign (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsPinpointCampaignRead(d, meta)
+}
+
+func resourceAwsPinpointCampaignDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).pinpointconn
+
+	log.Printf("[DEBUG] Deleting Pinpoint Campaign: %s", d.Id())
+	_, err := conn.DeleteCampaign(&pinpoint.DeleteCampaignInput{
+		ApplicationId: aws.String(d.Get("application_id").(string)),
+		CampaignId:    aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, pinpoint.ErrCodeNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Pinpoint Campaign (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsPinpointCampaignImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	applicationID, id, err := decodePinpointResourceID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("application_id", applicationID)
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandPinpointWriteCampaignRequest(d *schema.ResourceData) *pinpoint.WriteCampaignRequest {
+	request := &pinpoint.WriteCampaignRequest{
+		AdditionalTreatments: expandPinpointCampaignTreatments(d.Get("additional_treatment").([]interface{})),
+		Hook:                 expandPinpointCampaignHook(d.Get("hook").([]interface{})),
+		IsPaused:             aws.Bool(d.Get("is_paused").(bool)),
+		Limits:               expandPinpointCampaignLimits(d.Get("limits").([]interface{})),
+		MessageConfiguration: expandPinpointCampaignMessageConfiguration(d.Get("message_configuration").([]interface{})),
+		Name:                 aws.String(d.Get("name").(string)),
+		Schedule:             expandPinpointCampaignSchedule(d.Get("schedule").([]interface{})),
+		SegmentId:            aws.String(d.Get("segment_id").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		request.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("holdout_percent"); ok {
+		request.HoldoutPercent = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("segment_version"); ok {
+		request.SegmentVersion = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("treatment_description"); ok {
+		request.TreatmentDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("treatment_name"); ok {
+		request.TreatmentName = aws.String(v.(string))
+	}
+
+	return request
+}
+
+func pinpointCampaignMessageConfigurationSchema(required bool) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: required,
+		Optional: !required,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"adm_message":     pinpointCampaignMessageSchema(),
+				"apns_message":    pinpointCampaignMessageSchema(),
+				"baidu_message":   pinpointCampaignMessageSchema(),
+				"default_message": pinpointCampaignMessageSchema(),
+				"email_message": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"body": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"from_address": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"html_body": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"title": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+						},
+					},
+				},
+				"gcm_message": pinpointCampaignMessageSchema(),
+				"sms_message": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"body": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"message_type": {
+								Type:     schema.TypeString,
+								Optional: true,
+								ValidateFunc: validation.StringInSlice([]string{
+									pinpoint.MessageTypePromotional,
+									pinpoint.MessageTypeTransactional,
+								}, false),
+							},
+							"sender_id": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func pinpointCampaignMessageSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"action": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						pinpoint.ActionDeepLink,
+						pinpoint.ActionOpenApp,
+						pinpoint.ActionUrl,
+					}, false),
+				},
+				"body": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"image_icon_url": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"image_small_icon_url": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"image_url": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"json_body": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"media_url": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"raw_content": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"silent_push": {
+					Type:     schema.TypeBool,
+					Optional: true,
+				},
+				"time_to_live": {
+					Type:     schema.TypeInt,
+					Optional: true,
+				},
+				"title": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"url": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func pinpointCampaignScheduleSchema(required bool) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: required,
+		Optional: !required,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"start_time": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.ValidateRFC3339TimeString,
+				},
+				"end_time": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.ValidateRFC3339TimeString,
+				},
+				"event_filter": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"filter_type": {
+								Type:     schema.TypeString,
+								Required: true,
+								ValidateFunc: validation.StringInSlice([]string{
+									pinpoint.FilterTypeEndpoint,
+									pinpoint.FilterTypeSystem,
+								}, false),
+							},
+							"dimensions": {
+								Type:     schema.TypeList,
+								Required: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"attribute":  pinpointAttributeDimensionSchema(),
+										"event_type": pinpointSetDimensionSchema(),
+										"metric":     pinpointMetricDimensionSchema(),
+									},
+								},
+							},
+						},
+					},
+				},
+				"frequency": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						pinpoint.FrequencyDaily,
+						pinpoint.FrequencyEvent,
+						pinpoint.FrequencyHourly,
+						pinpoint.FrequencyMonthly,
+						pinpoint.FrequencyOnce,
+						pinpoint.FrequencyWeekly,
+					}, false),
+				},
+				"is_local_time": {
+					Type:     schema.TypeBool,
+					Optional: true,
+				},
+				"quiet_time": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"end": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"start": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+						},
+					},
+				},
+				"timezone": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func expandPinpointCampaignMessageConfiguration(l []interface{}) *pinpoint.MessageConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &pinpoint.MessageConfiguration{
+		ADMMessage:     expandPinpointCampaignMessage(m["adm_message"].([]interface{})),
+		APNSMessage:    expandPinpointCampaignMessage(m["apns_message"].([]interface{})),
+		BaiduMessage:   expandPinpointCampaignMessage(m["baidu_message"].([]interface{})),
+		DefaultMessage: expandPinpointCampaignMessage(m["default_message"].([]interface{})),
+		GCMMessage:     expandPinpointCampaignMessage(m["gcm_message"].([]interface{})),
+	}
+
+	if v, ok := m["email_message"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		email := v[0].(map[string]interface{})
+		config.EmailMessage = &pinpoint.CampaignEmailMessage{
+			Title: aws.String(email["title"].(string)),
+		}
+
+		if v, ok := email["body"].(string); ok && v != "" {
+			config.EmailMessage.Body = aws.String(v)
+		}
+
+		if v, ok := email["from_address"].(string); ok && v != "" {
+			config.EmailMessage.FromAddress = aws.String(v)
+		}
+
+		if v, ok := email["html_body"].(string); ok && v != "" {
+			config.EmailMessage.HtmlBody = aws.String(v)
+		}
+	}
+
+	if v, ok := m["sms_message"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		sms := v[0].(map[string]interface{})
+		config.SMSMessage = &pinpoint.CampaignSmsMessage{}
+
+		if v, ok := sms["body"].(string); ok && v != "" {
+			config.SMSMessage.Body = aws.String(v)
+		}
+
+		if v, ok := sms["message_type"].(string); ok && v != "" {
+			config.SMSMessage.MessageType = aws.String(v)
+		}
+
+		if v, ok := sms["sender_id"].(string); ok && v != "" {
+			config.SMSMessage.SenderId = aws.String(v)
+		}
+	}
+
+	return config
+}
+
+func flattenPinpointCampaignMessageConfiguration(config *pinpoint.MessageConfiguration) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"adm_message":     flattenPinpointCampaignMessage(config.ADMMessage),
+		"apns_message":    flattenPinpointCampaignMessage(config.APNSMessage),
+		"baidu_message":   flattenPinpointCampaignMessage(config.BaiduMessage),
+		"default_message": flattenPinpointCampaignMessage(config.DefaultMessage),
+		"email_message":   []interface{}{},
+		"gcm_message":     flattenPinpointCampaignMessage(config.GCMMessage),
+		"sms_message":     []interface{}{},
+	}
+
+	if email := config.EmailMessage; email != nil {
+		m["email_message"] = []interface{}{
+			map[string]interface{}{
+				"body":         aws.StringValue(email.Body),
+				"from_address": aws.StringValue(email.FromAddress),
+				"html_body":    aws.StringValue(email.HtmlBody),
+				"title":        aws.StringValue(email.Title),
+			},
+		}
+	}
+
+	if sms := config.SMSMessage; sms != nil {
+		m["sms_message"] = []interface{}{
+			map[string]interface{}{
+				"body":         aws.StringValue(sms.Body),
+				"message_type": aws.StringValue(sms.MessageType),
+				"sender_id":    aws.StringValue(sms.SenderId),
+			},
+		}
+	}
+
+	return []interface{}{m}
+}
+
+func expandPinpointCampaignMessage(l []interface{}) *pinpoint.Message {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	message := &pinpoint.Message{}
+
+	if v, ok := m["action"].(string); ok && v != "" {
+		message.Action = aws.String(v)
+	}
+
+	if v, ok := m["body"].(string); ok && v != "" {
+		message.Body = aws.String(v)
+	}
+
+	if v, ok := m["image_icon_url"].(string); ok && v != "" {
+		message.ImageIconUrl = aws.String(v)
+	}
+
+	if v, ok := m["image_small_icon_url"].(string); ok && v != "" {
+		message.ImageSmallIconUrl = aws.String(v)
+	}
+
+	if v, ok := m["image_url"].(string); ok && v != "" {
+		message.ImageUrl = aws.String(v)
+	}
+
+	if v, ok := m["json_body"].(string); ok && v != "" {
+		message.JsonBody = aws.String(v)
+	}
+
+	if v, ok := m["media_url"].(string); ok && v != "" {
+		message.MediaUrl = aws.String(v)
+	}
+
+	if v, ok := m["raw_content"].(string); ok && v != "" {
+		message.RawContent = aws.String(v)
+	}
+
+	if v, ok := m["silent_push"].(bool); ok && v {
+		message.SilentPush = aws.Bool(v)
+	}
+
+	if v, ok := m["time_to_live"].(int); ok && v != 0 {
+		message.TimeToLive = aws.Int64(int64(v))
+	}
+
+	if v, ok := m["title"].(string); ok && v != "" {
+		message.Title = aws.String(v)
+	}
+
+	if v, ok := m["url"].(string); ok && v != "" {
+		message.Url = aws.String(v)
+	}
+
+	return message
+}
+
+func flattenPinpointCampaignMessage(message *pinpoint.Message) []interface{} {
+	if message == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"action":               aws.StringValue(message.Action),
+		"body":                 aws.StringValue(message.Body),
+		"image_icon_url":       aws.StringValue(message.ImageIconUrl),
+		"image_small_icon_url": aws.StringValue(message.ImageSmallIconUrl),
+		"image_url":            aws.StringValue(message.ImageUrl),
+		"json_body":            aws.StringValue(message.JsonBody),
+		"media_url":            aws.StringValue(message.MediaUrl),
+		"raw_content":          aws.StringValue(message.RawContent),
+		"silent_push":          aws.BoolValue(message.SilentPush),
+		"time_to_live":         aws.Int64Value(message.TimeToLive),
+		"title":                aws.StringValue(message.Title),
+		"url":                  aws.StringValue(message.Url),
+	}
+
+	return []interface{}{m}
+}
+
+func expandPinpointCampaignSchedule(l []interface{}) *pinpoint.Schedule {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	schedule := &pinpoint.Schedule{
+		StartTime: aws.String(m["start_time"].(string)),
+	}
+
+	if v, ok := m["end_time"].(string); ok && v != "" {
+		schedule.EndTime = aws.String(v)
+	}
+
+	if v, ok := m["event_filter"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		filter := v[0].(map[string]interface{})
+		schedule.EventFilter = &pinpoint.CampaignEventFilter{
+			FilterType: aws.String(filter["filter_type"].(string)),
+		}
+
+		if v, ok := filter["dimensions"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			dimensions := v[0].(map[string]interface{})
+			schedule.EventFilter.Dimensions = &pinpoint.EventDimensions{
+				Attributes: expandPinpointAttributeDimensions(dimensions["attribute"].(*schema.Set)),
+				EventType:  expandPinpointSetDimension(dimensions["event_type"].([]interface{})),
+				Metrics:    expandPinpointMetricDimensions(dimensions["metric"].(*schema.Set)),
+			}
+		}
+	}
+
+	if v, ok := m["frequency"].(string); ok && v != "" {
+		schedule.Frequency = aws.String(v)
+	}
+
+	if v, ok := m["is_local_time"].(bool); ok {
+		schedule.IsLocalTime = aws.Bool(v)
+	}
+
+	if v, ok := m["quiet_time"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		schedule.QuietTime = expandPinpointQuietTime(v)
+	}
+
+	if v, ok := m["timezone"].(string); ok && v != "" {
+		schedule.Timezone = aws.String(v)
+	}
+
+	return schedule
+}
+
+func flattenPinpointCampaignSchedule(schedule *pinpoint.Schedule) []interface{} {
+	if schedule == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"end_time":      aws.StringValue(schedule.EndTime),
+		"event_filter":  []interface{}{},
+		"frequency":     aws.StringValue(schedule.Frequency),
+		"is_local_time": aws.BoolValue(schedule.IsLocalTime),
+		"quiet_time":    []interface{}{},
+		"start_time":    aws.StringValue(schedule.StartTime),
+		"timezone":      aws.StringValue(schedule.Timezone),
+	}
+
+	if filter := schedule.EventFilter; filter != nil {
+		dimensions := []interface{}{}
+
+		if filter.Dimensions != nil {
+			dimensions = append(dimensions, map[string]interface{}{
+				"attribute":  flattenPinpointAttributeDimensions(filter.Dimensions.Attributes),
+				"event_type": flattenPinpointSetDimension(filter.Dimensions.EventType),
+				"metric":     flattenPinpointMetricDimensions(filter.Dimensions.Metrics),
+			})
+		}
+
+		m["event_filter"] = []interface{}{
+			map[string]interface{}{
+				"dimensions":  dimensions,
+				"filter_type": aws.StringValue(filter.FilterType),
+			},
+		}
+	}
+
+	if schedule.QuietTime != nil && (aws.StringValue(schedule.QuietTime.Start) != "" || aws.StringValue(schedule.QuietTime.End) != "") {
+		m["quiet_time"] = flattenPinpointQuietTime(schedule.QuietTime)
+	}
+
+	return []interface{}{m}
+}
+
+func expandPinpointCampaignTreatments(l []interface{}) []*pinpoint.WriteTreatmentResource {
+	if len(l) == 0 {
+		return nil
+	}
+
+	treatments := make([]*pinpoint.WriteTreatmentResource, 0, len(l))
+
+	for _, v := range l {
+		if v == nil {
+			continue
+		}
+
+		m := v.(map[string]interface{})
+
+		treatment := &pinpoint.WriteTreatmentResource{
+			MessageConfiguration: expandPinpointCampaignMessageConfiguration(m["message_configuration"].([]interface{})),
+			Schedule:             expandPinpointCampaignSchedule(m["schedule"].([]interface{})),
+			SizePercent:          aws.Int64(int64(m["size_percent"].(int))),
+		}
+
+		if v, ok := m["treatment_description"].(string); ok && v != "" {
+			treatment.TreatmentDescription = aws.String(v)
+		}
+
+		if v, ok := m["treatment_name"].(string); ok && v != "" {
+			treatment.TreatmentName = aws.String(v)
+		}
+
+		treatments = append(treatments, treatment)
+	}
+
+	return treatments
+}
+
+func flattenPinpointCampaignTreatments(treatments []*pinpoint.TreatmentResource) []interface{} {
+	l := make([]interface{}, 0, len(treatments))
+
+	for _, treatment := range treatments {
+		if treatment == nil {
+			continue
+		}
+
+		l = append(l, map[string]interface{}{
+			"id":                    aws.StringValue(treatment.Id),
+			"message_configuration": flattenPinpointCampaignMessageConfiguration(treatment.MessageConfiguration),
+			"schedule":              flattenPinpointCampaignSchedule(treatment.Schedule),
+			"size_percent":          aws.Int64Value(treatment.SizePercent),
+			"treatment_description": aws.StringValue(treatment.TreatmentDescription),
+			"treatment_name":        aws.StringValue(treatment.TreatmentName),
+		})
+	}
+
+	return l
+}
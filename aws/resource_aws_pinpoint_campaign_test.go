@@ -0,0 +1,355 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSPinpointCampaign_basic(t *testing.T) {
+	oldDefaultRegion := os.Getenv("AWS_DEFAULT_REGION")
+	os.Setenv("AWS_DEFAULT_REGION", "us-east-1")
+	defer os.Setenv("AWS_DEFAULT_REGION", oldDefaultRegion)
+
+	var campaign pinpoint.CampaignResponse
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_pinpoint_campaign.test"
+	startTime := time.Now().UTC().Add(1 * time.Hour).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSPinpointCampaignDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSPinpointCampaignConfig(rName, startTime),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSPinpointCampaignExists(resourceName, &campaign),
+					resource.TestCheckResourceAttr(resourceName, "additional_treatment.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "application_id", "aws_pinpoint_app.test", "application_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "campaign_status"),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_date"),
+					resource.TestCheckResourceAttr(resourceName, "is_paused", "false"),
+					resource.TestCheckResourceAttr(resourceName, "message_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "message_configuration.0.default_message.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "message_configuration.0.default_message.0.body", "Hello from Terraform"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "schedule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.frequency", pinpoint.FrequencyOnce),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.start_time", startTime),
+					resource.TestCheckResourceAttrPair(resourceName, "segment_id", "aws_pinpoint_segment.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "segment_version", "aws_pinpoint_segment.test", "version"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccAWSPinpointResourceImportStateIdFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"segment_version"},
+			},
+		},
+	})
+}
+
+func TestAccAWSPinpointCampaign_Update(t *testing.T) {
+	oldDefaultRegion := os.Getenv("AWS_DEFAULT_REGION")
+	os.Setenv("AWS_DEFAULT_REGION", "us-east-1")
+	defer os.Setenv("AWS_DEFAULT_REGION", oldDefaultRegion)
+
+	var campaign pinpoint.CampaignResponse
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_pinpoint_campaign.test"
+	startTime := time.Now().UTC().Add(1 * time.Hour).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSPinpointCampaignDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSPinpointCampaignConfig(rName, startTime),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSPinpointCampaignExists(resourceName, &campaign),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				Config: testAccAWSPinpointCampaignConfigUpdated(rName, startTime),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSPinpointCampaignExists(resourceName, &campaign),
+					resource.TestCheckResourceAttr(resourceName, "additional_treatment.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "additional_treatment.0.id"),
+					resource.TestCheckResourceAttr(resourceName, "additional_treatment.0.size_percent", "40"),
+					resource.TestCheckResourceAttr(resourceName, "additional_treatment.0.treatment_name", "treatment-b"),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "holdout_percent", "10"),
+					resource.TestCheckResourceAttr(resourceName, "is_paused", "true"),
+					resource.TestCheckResourceAttr(resourceName, "limits.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "limits.0.daily", "3"),
+					resource.TestCheckResourceAttr(resourceName, "message_configuration.0.default_message.0.body", "Hello again from Terraform"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.quiet_time.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.quiet_time.0.start", "22:00"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.quiet_time.0.end", "07:00"),
+					resource.TestCheckResourceAttr(resourceName, "treatment_name", "treatment-a"),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccAWSPinpointResourceImportStateIdFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"segment_version"},
+			},
+		},
+	})
+}
+
+func TestAccAWSPinpointCampaign_Tags(t *testing.T) {
+	oldDefaultRegion := os.Getenv("AWS_DEFAULT_REGION")
+	os.Setenv("AWS_DEFAULT_REGION", "us-east-1")
+	defer os.Setenv("AWS_DEFAULT_REGION", oldDefaultRegion)
+
+	var campaign pinpoint.CampaignResponse
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_pinpoint_campaign.test"
+	startTime := time.Now().UTC().Add(1 * time.Hour).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSPinpointCampaignDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSPinpointCampaignConfigTags1(rName, startTime, "key1", "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSPinpointCampaignExists(resourceName, &campaign),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccAWSPinpointResourceImportStateIdFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"segment_version"},
+			},
+			{
+				Config: testAccAWSPinpointCampaignConfigTags2(rName, startTime, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSPinpointCampaignExists(resourceName, &campaign),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSPinpointCampaignConfigTags1(rName, startTime, "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSPinpointCampaignExists(resourceName, &campaign),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSPinpointCampaignExists(resourceName string, campaign *pinpoint.CampaignResponse) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Pinpoint Campaign ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).pinpointconn
+
+		output, err := conn.GetCampaign(&pinpoint.GetCampaignInput{
+			ApplicationId: aws.String(rs.Primary.Attributes["application_id"]),
+			CampaignId:    aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || output.CampaignResponse == nil {
+			return fmt.Errorf("Pinpoint Campaign (%s) not found", rs.Primary.ID)
+		}
+
+		*campaign = *output.CampaignResponse
+
+		return nil
+	}
+}
+
+func testAccCheckAWSPinpointCampaignDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).pinpointconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_pinpoint_campaign" {
+			continue
+		}
+
+		_, err := conn.GetCampaign(&pinpoint.GetCampaignInput{
+			ApplicationId: aws.String(rs.Primary.Attributes["application_id"]),
+			CampaignId:    aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, pinpoint.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Pinpoint Campaign (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSPinpointCampaignConfigBase(rName string) string {
+	return testAccAWSPinpointSegmentConfig(rName)
+}
+
+func testAccAWSPinpointCampaignConfig(rName, startTime string) string {
+	return testAccAWSPinpointCampaignConfigBase(rName) + fmt.Sprintf(`
+resource "aws_pinpoint_campaign" "test" {
+  application_id  = "${aws_pinpoint_app.test.application_id}"
+  name            = %[1]q
+  segment_id      = "${aws_pinpoint_segment.test.id}"
+  segment_version = "${aws_pinpoint_segment.test.version}"
+
+  message_configuration {
+    default_message {
+      body = "Hello from Terraform"
+    }
+  }
+
+  schedule {
+    frequency  = "ONCE"
+    start_time = %[2]q
+  }
+}
+`, rName, startTime)
+}
+
+func testAccAWSPinpointCampaignConfigUpdated(rName, startTime string) string {
+	return testAccAWSPinpointCampaignConfigBase(rName) + fmt.Sprintf(`
+resource "aws_pinpoint_campaign" "test" {
+  application_id  = "${aws_pinpoint_app.test.application_id}"
+  name            = %[1]q
+  description     = "updated"
+  holdout_percent = 10
+  is_paused       = true
+  segment_id      = "${aws_pinpoint_segment.test.id}"
+  segment_version = "${aws_pinpoint_segment.test.version}"
+  treatment_name  = "treatment-a"
+
+  limits {
+    daily = 3
+  }
+
+  message_configuration {
+    default_message {
+      body = "Hello again from Terraform"
+    }
+  }
+
+  schedule {
+    frequency  = "ONCE"
+    start_time = %[2]q
+
+    quiet_time {
+      start = "22:00"
+      end   = "07:00"
+    }
+  }
+
+  additional_treatment {
+    size_percent   = 40
+    treatment_name = "treatment-b"
+
+    message_configuration {
+      default_message {
+        body = "Hello from treatment B"
+      }
+    }
+
+    schedule {
+      frequency  = "ONCE"
+      start_time = %[2]q
+    }
+  }
+}
+`, rName, startTime)
+}
+
+func testAccAWSPinpointCampaignConfigTags1(rName, startTime, tagKey1, tagValue1 string) string {
+	return testAccAWSPinpointCampaignConfigBase(rName) + fmt.Sprintf(`
+resource "aws_pinpoint_campaign" "test" {
+  application_id = "${aws_pinpoint_app.test.application_id}"
+  name           = %[1]q
+  segment_id     = "${aws_pinpoint_segment.test.id}"
+
+  message_configuration {
+    default_message {
+      body = "Hello from Terraform"
+    }
+  }
+
+  schedule {
+    frequency  = "ONCE"
+    start_time = %[2]q
+  }
+
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rName, startTime, tagKey1, tagValue1)
+}
+
+func testAccAWSPinpointCampaignConfigTags2(rName, startTime, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return testAccAWSPinpointCampaignConfigBase(rName) + fmt.Sprintf(`
+resource "aws_pinpoint_campaign" "test" {
+  application_id = "${aws_pinpoint_app.test.application_id}"
+  name           = %[1]q
+  segment_id     = "${aws_pinpoint_segment.test.id}"
+
+  message_configuration {
+    default_message {
+      body = "Hello from Terraform"
+    }
+  }
+
+  schedule {
+    frequency  = "ONCE"
+    start_time = %[2]q
+  }
+
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+}
+`, rName, startTime, tagKey1, tagValue1, tagKey2, tagValue2)
+}
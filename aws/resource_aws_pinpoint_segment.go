@@ -0,0 +1,795 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsPinpointSegment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsPinpointSegmentCreate,
+		Read:   resourceAwsPinpointSegmentRead,
+		Update: resourceAwsPinpointSegmentUpdate,
+		Delete: resourceAwsPinpointSegmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsPinpointSegmentImport,
+		},
+
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			// Imported segments cannot be renamed in place
+			if diff.Id() != "" && len(diff.Get("import_definition").([]interface{})) > 0 && diff.HasChange("name") {
+				return diff.ForceNew("name")
+			}
+			return nil
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"dimensions": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"import_definition"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attribute": pinpointAttributeDimensionSchema(),
+						"behavior": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"recency": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"duration": {
+													Type:     schema.TypeString,
+													Required: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														pinpoint.DurationHr24,
+														pinpoint.DurationDay7,
+														pinpoint.DurationDay14,
+														pinpoint.DurationDay30,
+													}, false),
+												},
+												"recency_type": {
+													Type:     schema.TypeString,
+													Required: true,
+													ValidateFunc: validation.StringInSlice([]string{
+														pinpoint.RecencyTypeActive,
+														pinpoint.RecencyTypeInactive,
+													}, false),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"demographic": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"app_version": pinpointSetDimensionSchema(),
+									"channel":     pinpointSetDimensionSchema(),
+									"device_type": pinpointSetDimensionSchema(),
+									"make":        pinpointSetDimensionSchema(),
+									"model":       pinpointSetDimensionSchema(),
+									"platform":    pinpointSetDimensionSchema(),
+								},
+							},
+						},
+						"location": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"country": pinpointSetDimensionSchema(),
+									"gps_point": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"latitude": {
+													Type:         schema.TypeFloat,
+													Required:     true,
+													ValidateFunc: validation.FloatBetween(-90, 90),
+												},
+												"longitude": {
+													Type:         schema.TypeFloat,
+													Required:     true,
+													ValidateFunc: validation.FloatBetween(-180, 180),
+												},
+												"range_in_kilometers": {
+													Type:     schema.TypeFloat,
+													Optional: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"metric":         pinpointMetricDimensionSchema(),
+						"user_attribute": pinpointAttributeDimensionSchema(),
+					},
+				},
+			},
+			"import_definition": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"dimensions"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"external_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"format": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								pinpoint.FormatCsv,
+								pinpoint.FormatJson,
+							}, false),
+						},
+						"register_endpoints": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  true,
+						},
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validateArn,
+						},
+						"s3_url": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_modified_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"segment_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsPinpointSegmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).pinpointconn
+
+	applicationID := d.Get("application_id").(string)
+	name := d.Get("name").(string)
+
+	if v, ok := d.GetOk("import_definition"); ok {
+		m := v.([]interface{})[0].(map[string]interface{})
+
+		request := &pinpoint.ImportJobRequest{
+			DefineSegment:     aws.Bool(true),
+			Format:            aws.String(m["format"].(string)),
+			RegisterEndpoints: aws.Bool(m["register_endpoints"].(bool)),
+			RoleArn:           aws.String(m["role_arn"].(string)),
+			S3Url:             aws.String(m["s3_url"].(string)),
+			SegmentName:       aws.String(name),
+		}
+
+		if v, ok := m["external_id"].(string); ok && v != "" {
+			request.ExternalId = aws.String(v)
+		}
+
+		log.Printf("[DEBUG] Creating Pinpoint Segment import job: %s", request)
+		output, err := conn.CreateImportJob(&pinpoint.CreateImportJobInput{
+			ApplicationId:    aws.String(applicationID),
+			ImportJobRequest: request,
+		})
+
+		if err != nil {
+			return fmt.Errorf("error creating Pinpoint Segment (%s) import job: %s", name, err)
+		}
+
+		job, err := waitForPinpointImportJobCompletion(conn, applicationID, aws.StringValue(output.ImportJobResponse.Id), 10*time.Minute)
+
+		if err != nil {
+			return fmt.Errorf("error waiting for Pinpoint Segment (%s) import job completion: %s", name, err)
+		}
+
+		if job.Definition == nil || aws.StringValue(job.Definition.SegmentId) == "" {
+			return fmt.Errorf("error creating Pinpoint Segment (%s): import job (%s) did not return a segment ID", name, aws.StringValue(job.Id))
+		}
+
+		d.SetId(aws.StringValue(job.Definition.SegmentId))
+
+		if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+			segment, err := pinpointDescribeSegment(conn, applicationID, d.Id())
+
+			if err != nil {
+				return fmt.Errorf("error reading Pinpoint Segment (%s): %s", d.Id(), err)
+			}
+
+			if err := setTagsPinpoint(conn, d, aws.StringValue(segment.Arn)); err != nil {
+				return fmt.Errorf("error adding Pinpoint Segment (%s) tags: %s", d.Id(), err)
+			}
+		}
+
+		return resourceAwsPinpointSegmentRead(d, meta)
+	}
+
+	request := &pinpoint.WriteSegmentRequest{
+		Dimensions: expandPinpointSegmentDimensions(d.Get("dimensions").([]interface{})),
+		Name:       aws.String(name),
+	}
+
+	if v := d.Get("tags").(map[string]interface{}); len(v) > 0 {
+		request.Tags = tagsFromMapGeneric(v)
+	}
+
+	log.Printf("[DEBUG] Creating Pinpoint Segment: %s", request)
+	output, err := conn.CreateSegment(&pinpoint.CreateSegmentInput{
+		ApplicationId:       aws.String(applicationID),
+		WriteSegmentRequest: request,
+	})
+
+	if err != nil {
+		return fmt.Errorf("error creating Pinpoint Segment (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.SegmentResponse.Id))
+
+	return resourceAwsPinpointSegmentRead(d, meta)
+}
+
+func resourceAwsPinpointSegmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).pinpointconn
+
+	segment, err := pinpointDescribeSegment(conn, d.Get("application_id").(string), d.Id())
+
+	if isAWSErr(err, pinpoint.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] Pinpoint Segment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Pinpoint Segment (%s): %s", d.Id(), err)
+	}
+
+	d.Set("application_id", segment.ApplicationId)
+	d.Set("arn", segment.Arn)
+	d.Set("creation_date", segment.CreationDate)
+	d.Set("last_modified_date", segment.LastModifiedDate)
+	d.Set("name", segment.Name)
+	d.Set("segment_type", segment.SegmentType)
+	d.Set("version", segment.Version)
+
+	if err := d.Set("dimensions", flattenPinpointSegmentDimensions(segment.Dimensions)); err != nil {
+		return fmt.Errorf("error setting dimensions: %s", err)
+	}
+
+	if err := d.Set("import_definition", flattenPinpointSegmentImportDefinition(segment.ImportDefinition, d)); err != nil {
+		return fmt.Errorf("error setting import_definition: %s", err)
+	}
+
+	if err := d.Set("tags", tagsToMapGeneric(segment.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsPinpointSegmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).pinpointconn
+
+	if d.HasChange("name") || d.HasChange("dimensions") {
+		request := &pinpoint.WriteSegmentRequest{
+			Name: aws.String(d.Get("name").(string)),
+		}
+
+		if _, ok := d.GetOk("import_definition"); !ok {
+			request.Dimensions = expandPinpointSegmentDimensions(d.Get("dimensions").([]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating Pinpoint Segment (%s): %s", d.Id(), request)
+		_, err := conn.UpdateSegment(&pinpoint.UpdateSegmentInput{
+			ApplicationId:       aws.String(d.Get("application_id").(string)),
+			SegmentId:           aws.String(d.Id()),
+			WriteSegmentRequest: request,
+		})
+
+		if err != nil {
+			return fmt.Errorf("error updating Pinpoint Segment (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		if err := setTagsPinpoint(conn, d, d.Get("arn").(string)); err != nil {
+			return fmt.Errorf("error updating Pinpoint Segment (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsPinpointSegmentRead(d, meta)
+}
+
+func resourceAwsPinpointSegmentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).pinpointconn
+
+	log.Printf("[DEBUG] Deleting Pinpoint Segment: %s", d.Id())
+	_, err := conn.DeleteSegment(&pinpoint.DeleteSegmentInput{
+		ApplicationId: aws.String(d.Get("application_id").(string)),
+		SegmentId:     aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, pinpoint.ErrCodeNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Pinpoint Segment (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsPinpointSegmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	applicationID, id, err := decodePinpointResourceID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("application_id", applicationID)
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// decodePinpointResourceID splits an import ID of the form
+// "<application ID>/<resource ID>".
+func decodePinpointResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, "/")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected APPLICATION-ID/ID", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func pinpointDescribeSegment(conn *pinpoint.Pinpoint, applicationID, id string) (*pinpoint.SegmentResponse, error) {
+	output, err := conn.GetSegment(&pinpoint.GetSegmentInput{
+		ApplicationId: aws.String(applicationID),
+		SegmentId:     aws.String(id),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.SegmentResponse == nil {
+		return nil, fmt.Errorf("empty response")
+	}
+
+	return output.SegmentResponse, nil
+}
+
+func waitForPinpointImportJobCompletion(conn *pinpoint.Pinpoint, applicationID, jobID string, timeout time.Duration) (*pinpoint.ImportJobResponse, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			pinpoint.JobStatusCreated,
+			pinpoint.JobStatusInitializing,
+			pinpoint.JobStatusProcessing,
+			pinpoint.JobStatusCompleting,
+		},
+		Target:  []string{pinpoint.JobStatusCompleted},
+		Refresh: pinpointImportJobRefreshFunc(conn, applicationID, jobID),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	v, err := stateConf.WaitForState()
+
+	if job, ok := v.(*pinpoint.ImportJobResponse); ok {
+		if status := aws.StringValue(job.JobStatus); status == pinpoint.JobStatusFailing || status == pinpoint.JobStatusFailed {
+			return nil, fmt.Errorf("import job (%s) failed: %s", jobID, strings.Join(aws.StringValueSlice(job.Failures), ", "))
+		}
+		return job, err
+	}
+
+	return nil, err
+}
+
+func pinpointImportJobRefreshFunc(conn *pinpoint.Pinpoint, applicationID, jobID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.GetImportJob(&pinpoint.GetImportJobInput{
+			ApplicationId: aws.String(applicationID),
+			JobId:         aws.String(jobID),
+		})
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil || output.ImportJobResponse == nil {
+			return nil, "", nil
+		}
+
+		return output.ImportJobResponse, aws.StringValue(output.ImportJobResponse.JobStatus), nil
+	}
+}
+
+func pinpointAttributeDimensionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"attribute_type": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  pinpoint.AttributeTypeInclusive,
+					ValidateFunc: validation.StringInSlice([]string{
+						pinpoint.AttributeTypeInclusive,
+						pinpoint.AttributeTypeExclusive,
+					}, false),
+				},
+				"values": {
+					Type:     schema.TypeSet,
+					Required: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func pinpointMetricDimensionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"comparison_operator": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"EQUAL",
+						"GREATER_THAN",
+						"GREATER_THAN_OR_EQUAL",
+						"LESS_THAN",
+						"LESS_THAN_OR_EQUAL",
+					}, false),
+				},
+				"value": {
+					Type:     schema.TypeFloat,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func pinpointSetDimensionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"dimension_type": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  pinpoint.DimensionTypeInclusive,
+					ValidateFunc: validation.StringInSlice([]string{
+						pinpoint.DimensionTypeInclusive,
+						pinpoint.DimensionTypeExclusive,
+					}, false),
+				},
+				"values": {
+					Type:     schema.TypeSet,
+					Required: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func expandPinpointSegmentDimensions(l []interface{}) *pinpoint.SegmentDimensions {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	dimensions := &pinpoint.SegmentDimensions{
+		Attributes:     expandPinpointAttributeDimensions(m["attribute"].(*schema.Set)),
+		Metrics:        expandPinpointMetricDimensions(m["metric"].(*schema.Set)),
+		UserAttributes: expandPinpointAttributeDimensions(m["user_attribute"].(*schema.Set)),
+	}
+
+	if v, ok := m["behavior"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		recency := v[0].(map[string]interface{})["recency"].([]interface{})
+		if len(recency) > 0 && recency[0] != nil {
+			r := recency[0].(map[string]interface{})
+			dimensions.Behavior = &pinpoint.SegmentBehaviors{
+				Recency: &pinpoint.RecencyDimension{
+					Duration:    aws.String(r["duration"].(string)),
+					RecencyType: aws.String(r["recency_type"].(string)),
+				},
+			}
+		}
+	}
+
+	if v, ok := m["demographic"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		demographic := v[0].(map[string]interface{})
+		dimensions.Demographic = &pinpoint.SegmentDemographics{
+			AppVersion: expandPinpointSetDimension(demographic["app_version"].([]interface{})),
+			Channel:    expandPinpointSetDimension(demographic["channel"].([]interface{})),
+			DeviceType: expandPinpointSetDimension(demographic["device_type"].([]interface{})),
+			Make:       expandPinpointSetDimension(demographic["make"].([]interface{})),
+			Model:      expandPinpointSetDimension(demographic["model"].([]interface{})),
+			Platform:   expandPinpointSetDimension(demographic["platform"].([]interface{})),
+		}
+	}
+
+	if v, ok := m["location"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		location := v[0].(map[string]interface{})
+		dimensions.Location = &pinpoint.SegmentLocation{
+			Country: expandPinpointSetDimension(location["country"].([]interface{})),
+		}
+
+		if gps, ok := location["gps_point"].([]interface{}); ok && len(gps) > 0 && gps[0] != nil {
+			g := gps[0].(map[string]interface{})
+			dimensions.Location.GPSPoint = &pinpoint.GPSPointDimension{
+				Coordinates: &pinpoint.GPSCoordinates{
+					Latitude:  aws.Float64(g["latitude"].(float64)),
+					Longitude: aws.Float64(g["longitude"].(float64)),
+				},
+			}
+
+			if v, ok := g["range_in_kilometers"].(float64); ok && v != 0 {
+				dimensions.Location.GPSPoint.RangeInKilometers = aws.Float64(v)
+			}
+		}
+	}
+
+	return dimensions
+}
+
+func flattenPinpointSegmentDimensions(dimensions *pinpoint.SegmentDimensions) []interface{} {
+	if dimensions == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"attribute":      flattenPinpointAttributeDimensions(dimensions.Attributes),
+		"behavior":       []interface{}{},
+		"demographic":    []interface{}{},
+		"location":       []interface{}{},
+		"metric":         flattenPinpointMetricDimensions(dimensions.Metrics),
+		"user_attribute": flattenPinpointAttributeDimensions(dimensions.UserAttributes),
+	}
+
+	if dimensions.Behavior != nil && dimensions.Behavior.Recency != nil {
+		m["behavior"] = []interface{}{
+			map[string]interface{}{
+				"recency": []interface{}{
+					map[string]interface{}{
+						"duration":     aws.StringValue(dimensions.Behavior.Recency.Duration),
+						"recency_type": aws.StringValue(dimensions.Behavior.Recency.RecencyType),
+					},
+				},
+			},
+		}
+	}
+
+	if demographic := dimensions.Demographic; demographic != nil {
+		m["demographic"] = []interface{}{
+			map[string]interface{}{
+				"app_version": flattenPinpointSetDimension(demographic.AppVersion),
+				"channel":     flattenPinpointSetDimension(demographic.Channel),
+				"device_type": flattenPinpointSetDimension(demographic.DeviceType),
+				"make":        flattenPinpointSetDimension(demographic.Make),
+				"model":       flattenPinpointSetDimension(demographic.Model),
+				"platform":    flattenPinpointSetDimension(demographic.Platform),
+			},
+		}
+	}
+
+	if location := dimensions.Location; location != nil {
+		l := map[string]interface{}{
+			"country":   flattenPinpointSetDimension(location.Country),
+			"gps_point": []interface{}{},
+		}
+
+		if location.GPSPoint != nil && location.GPSPoint.Coordinates != nil {
+			l["gps_point"] = []interface{}{
+				map[string]interface{}{
+					"latitude":            aws.Float64Value(location.GPSPoint.Coordinates.Latitude),
+					"longitude":           aws.Float64Value(location.GPSPoint.Coordinates.Longitude),
+					"range_in_kilometers": aws.Float64Value(location.GPSPoint.RangeInKilometers),
+				},
+			}
+		}
+
+		m["location"] = []interface{}{l}
+	}
+
+	return []interface{}{m}
+}
+
+func flattenPinpointSegmentImportDefinition(definition *pinpoint.SegmentImportResource, d *schema.ResourceData) []interface{} {
+	if definition == nil {
+		return []interface{}{}
+	}
+
+	// register_endpoints is not returned by the API
+	registerEndpoints := true
+	if len(d.Get("import_definition").([]interface{})) > 0 {
+		registerEndpoints = d.Get("import_definition.0.register_endpoints").(bool)
+	}
+
+	m := map[string]interface{}{
+		"external_id":        aws.StringValue(definition.ExternalId),
+		"format":             aws.StringValue(definition.Format),
+		"register_endpoints": registerEndpoints,
+		"role_arn":           aws.StringValue(definition.RoleArn),
+		"s3_url":             aws.StringValue(definition.S3Url),
+	}
+
+	return []interface{}{m}
+}
+
+func expandPinpointAttributeDimensions(s *schema.Set) map[string]*pinpoint.AttributeDimension {
+	if s == nil || s.Len() == 0 {
+		return nil
+	}
+
+	attributes := make(map[string]*pinpoint.AttributeDimension, s.Len())
+
+	for _, v := range s.List() {
+		m := v.(map[string]interface{})
+		attributes[m["name"].(string)] = &pinpoint.AttributeDimension{
+			AttributeType: aws.String(m["attribute_type"].(string)),
+			Values:        expandStringSet(m["values"].(*schema.Set)),
+		}
+	}
+
+	return attributes
+}
+
+func flattenPinpointAttributeDimensions(attributes map[string]*pinpoint.AttributeDimension) []interface{} {
+	l := make([]interface{}, 0, len(attributes))
+
+	for name, attribute := range attributes {
+		if attribute == nil {
+			continue
+		}
+
+		l = append(l, map[string]interface{}{
+			"name":           name,
+			"attribute_type": aws.StringValue(attribute.AttributeType),
+			"values":         schema.NewSet(schema.HashString, flattenStringList(attribute.Values)),
+		})
+	}
+
+	return l
+}
+
+func expandPinpointMetricDimensions(s *schema.Set) map[string]*pinpoint.MetricDimension {
+	if s == nil || s.Len() == 0 {
+		return nil
+	}
+
+	metrics := make(map[string]*pinpoint.MetricDimension, s.Len())
+
+	for _, v := range s.List() {
+		m := v.(map[string]interface{})
+		metrics[m["name"].(string)] = &pinpoint.MetricDimension{
+			ComparisonOperator: aws.String(m["comparison_operator"].(string)),
+			Value:              aws.Float64(m["value"].(float64)),
+		}
+	}
+
+	return metrics
+}
+
+func flattenPinpointMetricDimensions(metrics map[string]*pinpoint.MetricDimension) []interface{} {
+	l := make([]interface{}, 0, len(metrics))
+
+	for name, metric := range metrics {
+		if metric == nil {
+			continue
+		}
+
+		l = append(l, map[string]interface{}{
+			"name":                name,
+			"comparison_operator": aws.StringValue(metric.ComparisonOperator),
+			"value":               aws.Float64Value(metric.Value),
+		})
+	}
+
+	return l
+}
+
+func expandPinpointSetDimension(l []interface{}) *pinpoint.SetDimension {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &pinpoint.SetDimension{
+		DimensionType: aws.String(m["dimension_type"].(string)),
+		Values:        expandStringSet(m["values"].(*schema.Set)),
+	}
+}
+
+func flattenPinpointSetDimension(dimension *pinpoint.SetDimension) []interface{} {
+	if dimension == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"dimension_type": aws.StringValue(dimension.DimensionType),
+		"values":         schema.NewSet(schema.HashString, flattenStringList(dimension.Values)),
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,486 @@
+package aws
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestDecodePinpointResourceID(t *testing.T) {
+	var testCases = []struct {
+		Input         string
+		ExpectedParts []string
+		ErrCount      int
+	}{
+		{
+			Input:    "",
+			ErrCount: 1,
+		},
+		{
+			Input:    "abcdef0123456789",
+			ErrCount: 1,
+		},
+		{
+			Input:    "abcdef0123456789/",
+			ErrCount: 1,
+		},
+		{
+			Input:    "/0123456789abcdef",
+			ErrCount: 1,
+		},
+		{
+			Input:    "abcdef0123456789/0123456789abcdef/extra",
+			ErrCount: 1,
+		},
+		{
+			Input:         "abcdef0123456789/0123456789abcdef",
+			ExpectedParts: []string{"abcdef0123456789", "0123456789abcdef"},
+			ErrCount:      0,
+		},
+	}
+
+	for _, tc := range testCases {
+		applicationID, id, err := decodePinpointResourceID(tc.Input)
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("expected %q not to trigger an error, received: %s", tc.Input, err)
+		}
+		if tc.ErrCount > 0 && err == nil {
+			t.Fatalf("expected %q to trigger an error", tc.Input)
+		}
+		if tc.ErrCount > 0 {
+			continue
+		}
+		if applicationID != tc.ExpectedParts[0] || id != tc.ExpectedParts[1] {
+			t.Fatalf("expected parts %v, received: [%s %s]", tc.ExpectedParts, applicationID, id)
+		}
+	}
+}
+
+func TestAccAWSPinpointSegment_basic(t *testing.T) {
+	oldDefaultRegion := os.Getenv("AWS_DEFAULT_REGION")
+	os.Setenv("AWS_DEFAULT_REGION", "us-east-1")
+	defer os.Setenv("AWS_DEFAULT_REGION", oldDefaultRegion)
+
+	var segment pinpoint.SegmentResponse
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_pinpoint_segment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSPinpointSegmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSPinpointSegmentConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSPinpointSegmentExists(resourceName, &segment),
+					resource.TestCheckResourceAttrPair(resourceName, "application_id", "aws_pinpoint_app.test", "application_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_date"),
+					resource.TestCheckResourceAttr(resourceName, "dimensions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dimensions.0.demographic.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dimensions.0.demographic.0.platform.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dimensions.0.demographic.0.platform.0.dimension_type", "INCLUSIVE"),
+					resource.TestCheckResourceAttr(resourceName, "dimensions.0.demographic.0.platform.0.values.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "import_definition.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "segment_type", pinpoint.SegmentTypeDimensional),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSPinpointResourceImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSPinpointSegment_Dimensions(t *testing.T) {
+	oldDefaultRegion := os.Getenv("AWS_DEFAULT_REGION")
+	os.Setenv("AWS_DEFAULT_REGION", "us-east-1")
+	defer os.Setenv("AWS_DEFAULT_REGION", oldDefaultRegion)
+
+	var segment pinpoint.SegmentResponse
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_pinpoint_segment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSPinpointSegmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSPinpointSegmentConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSPinpointSegmentExists(resourceName, &segment),
+					resource.TestCheckResourceAttr(resourceName, "dimensions.0.attribute.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "dimensions.0.behavior.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				Config: testAccAWSPinpointSegmentConfigDimensions(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSPinpointSegmentExists(resourceName, &segment),
+					resource.TestCheckResourceAttr(resourceName, "dimensions.0.attribute.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dimensions.0.behavior.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dimensions.0.behavior.0.recency.0.duration", pinpoint.DurationDay7),
+					resource.TestCheckResourceAttr(resourceName, "dimensions.0.behavior.0.recency.0.recency_type", pinpoint.RecencyTypeActive),
+					resource.TestCheckResourceAttr(resourceName, "dimensions.0.location.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dimensions.0.location.0.country.0.values.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "dimensions.0.metric.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dimensions.0.user_attribute.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("%s-updated", rName)),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSPinpointResourceImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSPinpointSegment_Tags(t *testing.T) {
+	oldDefaultRegion := os.Getenv("AWS_DEFAULT_REGION")
+	os.Setenv("AWS_DEFAULT_REGION", "us-east-1")
+	defer os.Setenv("AWS_DEFAULT_REGION", oldDefaultRegion)
+
+	var segment pinpoint.SegmentResponse
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_pinpoint_segment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSPinpointSegmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSPinpointSegmentConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSPinpointSegmentExists(resourceName, &segment),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSPinpointResourceImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSPinpointSegmentConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSPinpointSegmentExists(resourceName, &segment),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSPinpointSegmentConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSPinpointSegmentExists(resourceName, &segment),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSPinpointSegment_ImportDefinition(t *testing.T) {
+	oldDefaultRegion := os.Getenv("AWS_DEFAULT_REGION")
+	os.Setenv("AWS_DEFAULT_REGION", "us-east-1")
+	defer os.Setenv("AWS_DEFAULT_REGION", oldDefaultRegion)
+
+	var segment pinpoint.SegmentResponse
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_pinpoint_segment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSPinpointSegmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSPinpointSegmentConfigImportDefinition(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSPinpointSegmentExists(resourceName, &segment),
+					resource.TestCheckResourceAttr(resourceName, "dimensions.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "import_definition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "import_definition.0.format", pinpoint.FormatCsv),
+					resource.TestCheckResourceAttr(resourceName, "import_definition.0.register_endpoints", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "import_definition.0.role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "segment_type", pinpoint.SegmentTypeImport),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSPinpointResourceImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSPinpointSegmentExists(resourceName string, segment *pinpoint.SegmentResponse) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Pinpoint Segment ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).pinpointconn
+
+		output, err := pinpointDescribeSegment(conn, rs.Primary.Attributes["application_id"], rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*segment = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSPinpointSegmentDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).pinpointconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_pinpoint_segment" {
+			continue
+		}
+
+		_, err := pinpointDescribeSegment(conn, rs.Primary.Attributes["application_id"], rs.Primary.ID)
+
+		if isAWSErr(err, pinpoint.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Pinpoint Segment (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSPinpointResourceImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["application_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccAWSPinpointSegmentConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_pinpoint_app" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSPinpointSegmentConfig(rName string) string {
+	return testAccAWSPinpointSegmentConfigBase(rName) + fmt.Sprintf(`
+resource "aws_pinpoint_segment" "test" {
+  application_id = "${aws_pinpoint_app.test.application_id}"
+  name           = %[1]q
+
+  dimensions {
+    demographic {
+      platform {
+        values = ["ANDROID"]
+      }
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAWSPinpointSegmentConfigDimensions(rName string) string {
+	return testAccAWSPinpointSegmentConfigBase(rName) + fmt.Sprintf(`
+resource "aws_pinpoint_segment" "test" {
+  application_id = "${aws_pinpoint_app.test.application_id}"
+  name           = "%[1]s-updated"
+
+  dimensions {
+    attribute {
+      name           = "favorite_color"
+      attribute_type = "EXCLUSIVE"
+      values         = ["green"]
+    }
+
+    behavior {
+      recency {
+        duration     = "DAY_7"
+        recency_type = "ACTIVE"
+      }
+    }
+
+    demographic {
+      platform {
+        values = ["ANDROID", "IOS"]
+      }
+    }
+
+    location {
+      country {
+        values = ["US", "CA"]
+      }
+    }
+
+    metric {
+      name                = "purchases"
+      comparison_operator = "GREATER_THAN"
+      value               = 5
+    }
+
+    user_attribute {
+      name   = "plan"
+      values = ["premium"]
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAWSPinpointSegmentConfigImportDefinition(rName string) string {
+	return testAccAWSPinpointSegmentConfigBase(rName) + fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_object" "test" {
+  bucket  = "${aws_s3_bucket.test.id}"
+  key     = "endpoints.csv"
+  content = "ChannelType,Address\nEMAIL,tf-acc-test@example.com\n"
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "pinpoint.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = "${aws_iam_role.test.id}"
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "s3:GetObject",
+        "s3:ListBucket"
+      ],
+      "Resource": [
+        "${aws_s3_bucket.test.arn}",
+        "${aws_s3_bucket.test.arn}/*"
+      ]
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_pinpoint_segment" "test" {
+  application_id = "${aws_pinpoint_app.test.application_id}"
+  name           = %[1]q
+
+  import_definition {
+    format   = "CSV"
+    role_arn = "${aws_iam_role.test.arn}"
+    s3_url   = "s3://${aws_s3_bucket.test.id}/${aws_s3_bucket_object.test.key}"
+  }
+
+  depends_on = ["aws_iam_role_policy.test"]
+}
+`, rName)
+}
+
+func testAccAWSPinpointSegmentConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return testAccAWSPinpointSegmentConfigBase(rName) + fmt.Sprintf(`
+resource "aws_pinpoint_segment" "test" {
+  application_id = "${aws_pinpoint_app.test.application_id}"
+  name           = %[1]q
+
+  dimensions {
+    demographic {
+      platform {
+        values = ["ANDROID"]
+      }
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccAWSPinpointSegmentConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return testAccAWSPinpointSegmentConfigBase(rName) + fmt.Sprintf(`
+resource "aws_pinpoint_segment" "test" {
+  application_id = "${aws_pinpoint_app.test.application_id}"
+  name           = %[1]q
+
+  dimensions {
+    demographic {
+      platform {
+        values = ["ANDROID"]
+      }
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,201 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsRoute53TrafficPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsRoute53TrafficPolicyCreate,
+		Read:   resourceAwsRoute53TrafficPolicyRead,
+		Update: resourceAwsRoute53TrafficPolicyUpdate,
+		Delete: resourceAwsRoute53TrafficPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"comment": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			"document": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.ValidateJsonString,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 512),
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsRoute53TrafficPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).r53conn
+
+	input := &route53.CreateTrafficPolicyInput{
+		Document: aws.String(d.Get("document").(string)),
+		Name:     aws.String(d.Get("name").(string)),
+	}
+
+	if v, ok := d.GetOk("comment"); ok {
+		input.Comment = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Route53 traffic policy: %s", input)
+	output, err := conn.CreateTrafficPolicy(input)
+	if err != nil {
+		return fmt.Errorf("error creating Route53 traffic policy: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.TrafficPolicy.Id))
+
+	return resourceAwsRoute53TrafficPolicyRead(d, meta)
+}
+
+func resourceAwsRoute53TrafficPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).r53conn
+
+	versions, err := route53ListTrafficPolicyVersions(conn, d.Id())
+	if isAWSErr(err, route53.ErrCodeNoSuchTrafficPolicy, "") {
+		log.Printf("[WARN] Route53 traffic policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error listing Route53 traffic policy (%s) versions: %s", d.Id(), err)
+	}
+
+	var trafficPolicy *route53.TrafficPolicy
+	for _, v := range versions {
+		if trafficPolicy == nil || aws.Int64Value(v.Version) > aws.Int64Value(trafficPolicy.Version) {
+			trafficPolicy = v
+		}
+	}
+
+	if trafficPolicy == nil {
+		log.Printf("[WARN] Route53 traffic policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("comment", trafficPolicy.Comment)
+	d.Set("document", trafficPolicy.Document)
+	d.Set("name", trafficPolicy.Name)
+	d.Set("type", trafficPolicy.Type)
+	d.Set("version", trafficPolicy.Version)
+
+	return nil
+}
+
+func resourceAwsRoute53TrafficPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).r53conn
+
+	if d.HasChange("document") {
+		input := &route53.CreateTrafficPolicyVersionInput{
+			Document: aws.String(d.Get("document").(string)),
+			Id:       aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("comment"); ok {
+			input.Comment = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Creating Route53 traffic policy version: %s", input)
+		if _, err := conn.CreateTrafficPolicyVersion(input); err != nil {
+			return fmt.Errorf("error creating Route53 traffic policy (%s) version: %s", d.Id(), err)
+		}
+
+		return resourceAwsRoute53TrafficPolicyRead(d, meta)
+	}
+
+	if d.HasChange("comment") {
+		input := &route53.UpdateTrafficPolicyCommentInput{
+			Comment: aws.String(d.Get("comment").(string)),
+			Id:      aws.String(d.Id()),
+			Version: aws.Int64(int64(d.Get("version").(int))),
+		}
+
+		log.Printf("[DEBUG] Updating Route53 traffic policy comment: %s", input)
+		if _, err := conn.UpdateTrafficPolicyComment(input); err != nil {
+			return fmt.Errorf("error updating Route53 traffic policy (%s) comment: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsRoute53TrafficPolicyRead(d, meta)
+}
+
+func resourceAwsRoute53TrafficPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).r53conn
+
+	versions, err := route53ListTrafficPolicyVersions(conn, d.Id())
+	if isAWSErr(err, route53.ErrCodeNoSuchTrafficPolicy, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error listing Route53 traffic policy (%s) versions: %s", d.Id(), err)
+	}
+
+	for _, v := range versions {
+		input := &route53.DeleteTrafficPolicyInput{
+			Id:      v.Id,
+			Version: v.Version,
+		}
+
+		log.Printf("[DEBUG] Deleting Route53 traffic policy version: %s", input)
+		_, err := conn.DeleteTrafficPolicy(input)
+		if isAWSErr(err, route53.ErrCodeNoSuchTrafficPolicy, "") {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("error deleting Route53 traffic policy (%s) version %d: %s", d.Id(), aws.Int64Value(v.Version), err)
+		}
+	}
+
+	return nil
+}
+
+func route53ListTrafficPolicyVersions(conn *route53.Route53, id string) ([]*route53.TrafficPolicy, error) {
+	var versions []*route53.TrafficPolicy
+
+	input := &route53.ListTrafficPolicyVersionsInput{
+		Id: aws.String(id),
+	}
+
+	for {
+		output, err := conn.ListTrafficPolicyVersions(input)
+		if err != nil {
+			return nil, err
+		}
+
+		versions = append(versions, output.TrafficPolicies...)
+
+		if !aws.BoolValue(output.IsTruncated) {
+			break
+		}
+
+		input.TrafficPolicyVersionMarker = output.TrafficPolicyVersionMarker
+	}
+
+	return versions, nil
+}
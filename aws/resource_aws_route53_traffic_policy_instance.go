@@ -0,0 +1,215 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	route53TrafficPolicyInstanceStateApplied  = "Applied"
+	route53TrafficPolicyInstanceStateCreating = "Creating"
+	route53TrafficPolicyInstanceStateDeleting = "Deleting"
+	route53TrafficPolicyInstanceStateFailed   = "Failed"
+	route53TrafficPolicyInstanceStateUpdating = "Updating"
+)
+
+func resourceAwsRoute53TrafficPolicyInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsRoute53TrafficPolicyInstanceCreate,
+		Read:   resourceAwsRoute53TrafficPolicyInstanceRead,
+		Update: resourceAwsRoute53TrafficPolicyInstanceUpdate,
+		Delete: resourceAwsRoute53TrafficPolicyInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"hosted_zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				StateFunc: func(v interface{}) string {
+					return strings.TrimSuffix(v.(string), ".")
+				},
+			},
+			"traffic_policy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"traffic_policy_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"traffic_policy_version": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"ttl": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 2147483647),
+			},
+		},
+	}
+}
+
+func resourceAwsRoute53TrafficPolicyInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).r53conn
+
+	input := &route53.CreateTrafficPolicyInstanceInput{
+		HostedZoneId:         aws.String(d.Get("hosted_zone_id").(string)),
+		Name:                 aws.String(d.Get("name").(string)),
+		TTL:                  aws.Int64(int64(d.Get("ttl").(int))),
+		TrafficPolicyId:      aws.String(d.Get("traffic_policy_id").(string)),
+		TrafficPolicyVersion: aws.Int64(int64(d.Get("traffic_policy_version").(int))),
+	}
+
+	log.Printf("[DEBUG] Creating Route53 traffic policy instance: %s", input)
+	output, err := conn.CreateTrafficPolicyInstance(input)
+	if err != nil {
+		return fmt.Errorf("error creating Route53 traffic policy instance: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.TrafficPolicyInstance.Id))
+
+	if err := waitForRoute53TrafficPolicyInstanceApplied(conn, d.Id(), []string{route53TrafficPolicyInstanceStateCreating}, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for Route53 traffic policy instance (%s) creation: %s", d.Id(), err)
+	}
+
+	return resourceAwsRoute53TrafficPolicyInstanceRead(d, meta)
+}
+
+func resourceAwsRoute53TrafficPolicyInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).r53conn
+
+	output, err := conn.GetTrafficPolicyInstance(&route53.GetTrafficPolicyInstanceInput{
+		Id: aws.String(d.Id()),
+	})
+	if isAWSErr(err, route53.ErrCodeNoSuchTrafficPolicyInstance, "") {
+		log.Printf("[WARN] Route53 traffic policy instance (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading Route53 traffic policy instance (%s): %s", d.Id(), err)
+	}
+
+	instance := output.TrafficPolicyInstance
+
+	d.Set("hosted_zone_id", instance.HostedZoneId)
+	d.Set("name", strings.TrimSuffix(aws.StringValue(instance.Name), "."))
+	d.Set("traffic_policy_id", instance.TrafficPolicyId)
+	d.Set("traffic_policy_type", instance.TrafficPolicyType)
+	d.Set("traffic_policy_version", instance.TrafficPolicyVersion)
+	d.Set("ttl", instance.TTL)
+
+	return nil
+}
+
+func resourceAwsRoute53TrafficPolicyInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).r53conn
+
+	input := &route53.UpdateTrafficPolicyInstanceInput{
+		Id:                   aws.String(d.Id()),
+		TTL:                  aws.Int64(int64(d.Get("ttl").(int))),
+		TrafficPolicyId:      aws.String(d.Get("traffic_policy_id").(string)),
+		TrafficPolicyVersion: aws.Int64(int64(d.Get("traffic_policy_version").(int))),
+	}
+
+	log.Printf("[DEBUG] Updating Route53 traffic policy instance: %s", input)
+	if _, err := conn.UpdateTrafficPolicyInstance(input); err != nil {
+		return fmt.Errorf("error updating Route53 traffic policy instance (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForRoute53TrafficPolicyInstanceApplied(conn, d.Id(), []string{route53TrafficPolicyInstanceStateUpdating}, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("error waiting for Route53 traffic policy instance (%s) update: %s", d.Id(), err)
+	}
+
+	return resourceAwsRoute53TrafficPolicyInstanceRead(d, meta)
+}
+
+func resourceAwsRoute53TrafficPolicyInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).r53conn
+
+	log.Printf("[DEBUG] Deleting Route53 traffic policy instance: %s", d.Id())
+	_, err := conn.DeleteTrafficPolicyInstance(&route53.DeleteTrafficPolicyInstanceInput{
+		Id: aws.String(d.Id()),
+	})
+	if isAWSErr(err, route53.ErrCodeNoSuchTrafficPolicyInstance, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error deleting Route53 traffic policy instance (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{route53TrafficPolicyInstanceStateDeleting},
+		Target:  []string{},
+		Refresh: route53TrafficPolicyInstanceRefreshFunc(conn, d.Id()),
+		Timeout: d.Timeout(schema.TimeoutDelete),
+		Delay:   5 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for Route53 traffic policy instance (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func route53TrafficPolicyInstanceRefreshFunc(conn *route53.Route53, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.GetTrafficPolicyInstance(&route53.GetTrafficPolicyInstanceInput{
+			Id: aws.String(id),
+		})
+		if isAWSErr(err, route53.ErrCodeNoSuchTrafficPolicyInstance, "") {
+			return nil, "", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		instance := output.TrafficPolicyInstance
+		state := aws.StringValue(instance.State)
+
+		if state == route53TrafficPolicyInstanceStateFailed {
+			return instance, state, fmt.Errorf("%s", aws.StringValue(instance.Message))
+		}
+
+		return instance, state, nil
+	}
+}
+
+func waitForRoute53TrafficPolicyInstanceApplied(conn *route53.Route53, id string, pending []string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: pending,
+		Target:  []string{route53TrafficPolicyInstanceStateApplied},
+		Refresh: route53TrafficPolicyInstanceRefreshFunc(conn, id),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
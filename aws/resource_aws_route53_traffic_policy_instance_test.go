@@ -0,0 +1,137 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSRoute53TrafficPolicyInstance_basic(t *testing.T) {
+	var instance route53.TrafficPolicyInstance
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	zoneName := fmt.Sprintf("%s.com", rName)
+	resourceName := "aws_route53_traffic_policy_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRoute53TrafficPolicyInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRoute53TrafficPolicyInstanceConfig(rName, zoneName, 360),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRoute53TrafficPolicyInstanceExists(resourceName, &instance),
+					resource.TestCheckResourceAttrPair(resourceName, "hosted_zone_id", "aws_route53_zone.test", "zone_id"),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("www.%s", zoneName)),
+					resource.TestCheckResourceAttrPair(resourceName, "traffic_policy_id", "aws_route53_traffic_policy.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_policy_type", "A"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_policy_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "360"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSRoute53TrafficPolicyInstanceConfig(rName, zoneName, 7200),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRoute53TrafficPolicyInstanceExists(resourceName, &instance),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "7200"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSRoute53TrafficPolicyInstanceExists(resourceName string, instance *route53.TrafficPolicyInstance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Route53 traffic policy instance ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).r53conn
+
+		output, err := conn.GetTrafficPolicyInstance(&route53.GetTrafficPolicyInstanceInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		*instance = *output.TrafficPolicyInstance
+
+		return nil
+	}
+}
+
+func testAccCheckAWSRoute53TrafficPolicyInstanceDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).r53conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_route53_traffic_policy_instance" {
+			continue
+		}
+
+		_, err := conn.GetTrafficPolicyInstance(&route53.GetTrafficPolicyInstanceInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, route53.ErrCodeNoSuchTrafficPolicyInstance, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Route53 traffic policy instance (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSRoute53TrafficPolicyInstanceConfig(rName, zoneName string, ttl int) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[2]q
+}
+
+resource "aws_route53_traffic_policy" "test" {
+  name     = %[1]q
+  document = <<EOT
+{
+  "AWSPolicyFormatVersion": "2015-10-01",
+  "RecordType": "A",
+  "Endpoints": {
+    "endpoint-start": {
+      "Type": "value",
+      "Value": "1.2.3.4"
+    }
+  },
+  "StartEndpoint": "endpoint-start"
+}
+EOT
+}
+
+resource "aws_route53_traffic_policy_instance" "test" {
+  hosted_zone_id         = "${aws_route53_zone.test.zone_id}"
+  name                   = "www.%[2]s"
+  traffic_policy_id      = "${aws_route53_traffic_policy.test.id}"
+  traffic_policy_version = "${aws_route53_traffic_policy.test.version}"
+  ttl                    = %[3]d
+}
+`, rName, zoneName, ttl)
+}
@@ -0,0 +1,241 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSRoute53TrafficPolicy_basic(t *testing.T) {
+	var trafficPolicy route53.TrafficPolicy
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_route53_traffic_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRoute53TrafficPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRoute53TrafficPolicyConfig(rName, "1.2.3.4"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRoute53TrafficPolicyExists(resourceName, &trafficPolicy),
+					resource.TestCheckResourceAttr(resourceName, "comment", ""),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "type", "A"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSRoute53TrafficPolicy_disappears(t *testing.T) {
+	var trafficPolicy route53.TrafficPolicy
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_route53_traffic_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRoute53TrafficPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRoute53TrafficPolicyConfig(rName, "1.2.3.4"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRoute53TrafficPolicyExists(resourceName, &trafficPolicy),
+					testAccCheckAWSRoute53TrafficPolicyDisappears(&trafficPolicy),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSRoute53TrafficPolicy_Comment(t *testing.T) {
+	var trafficPolicy route53.TrafficPolicy
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_route53_traffic_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRoute53TrafficPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRoute53TrafficPolicyConfigComment(rName, "comment1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRoute53TrafficPolicyExists(resourceName, &trafficPolicy),
+					resource.TestCheckResourceAttr(resourceName, "comment", "comment1"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSRoute53TrafficPolicyConfigComment(rName, "comment2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRoute53TrafficPolicyExists(resourceName, &trafficPolicy),
+					resource.TestCheckResourceAttr(resourceName, "comment", "comment2"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSRoute53TrafficPolicy_Document(t *testing.T) {
+	var trafficPolicy route53.TrafficPolicy
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_route53_traffic_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRoute53TrafficPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRoute53TrafficPolicyConfig(rName, "1.2.3.4"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRoute53TrafficPolicyExists(resourceName, &trafficPolicy),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				Config: testAccAWSRoute53TrafficPolicyConfig(rName, "5.6.7.8"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRoute53TrafficPolicyExists(resourceName, &trafficPolicy),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSRoute53TrafficPolicyExists(resourceName string, trafficPolicy *route53.TrafficPolicy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Route53 traffic policy ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).r53conn
+
+		output, err := conn.GetTrafficPolicy(&route53.GetTrafficPolicyInput{
+			Id:      aws.String(rs.Primary.ID),
+			Version: aws.Int64(1),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		*trafficPolicy = *output.TrafficPolicy
+
+		return nil
+	}
+}
+
+func testAccCheckAWSRoute53TrafficPolicyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).r53conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_route53_traffic_policy" {
+			continue
+		}
+
+		versions, err := route53ListTrafficPolicyVersions(conn, rs.Primary.ID)
+
+		if isAWSErr(err, route53.ErrCodeNoSuchTrafficPolicy, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if len(versions) > 0 {
+			return fmt.Errorf("Route53 traffic policy (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSRoute53TrafficPolicyDisappears(trafficPolicy *route53.TrafficPolicy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).r53conn
+
+		_, err := conn.DeleteTrafficPolicy(&route53.DeleteTrafficPolicyInput{
+			Id:      trafficPolicy.Id,
+			Version: trafficPolicy.Version,
+		})
+
+		return err
+	}
+}
+
+func testAccAWSRoute53TrafficPolicyConfig(rName, value string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_traffic_policy" "test" {
+  name     = %[1]q
+  document = <<EOT
+{
+  "AWSPolicyFormatVersion": "2015-10-01",
+  "RecordType": "A",
+  "Endpoints": {
+    "endpoint-start": {
+      "Type": "value",
+      "Value": %[2]q
+    }
+  },
+  "StartEndpoint": "endpoint-start"
+}
+EOT
+}
+`, rName, value)
+}
+
+func testAccAWSRoute53TrafficPolicyConfigComment(rName, comment string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_traffic_policy" "test" {
+  name     = %[1]q
+  comment  = %[2]q
+  document = <<EOT
+{
+  "AWSPolicyFormatVersion": "2015-10-01",
+  "RecordType": "A",
+  "Endpoints": {
+    "endpoint-start": {
+      "Type": "value",
+      "Value": "1.2.3.4"
+    }
+  },
+  "StartEndpoint": "endpoint-start"
+}
+EOT
+}
+`, rName, comment)
+}
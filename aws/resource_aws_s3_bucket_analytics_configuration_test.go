@@ -0,0 +1,368 @@
+package aws
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestExpandS3AnalyticsFilter(t *testing.T) {
+	testCases := []struct {
+		Config                    []interface{}
+		ExpectedS3AnalyticsFilter *s3.AnalyticsFilter
+	}{
+		{
+			Config:                    nil,
+			ExpectedS3AnalyticsFilter: nil,
+		},
+		{
+			Config: []interface{}{
+				map[string]interface{}{
+					"prefix": "prefix/",
+				},
+			},
+			ExpectedS3AnalyticsFilter: &s3.AnalyticsFilter{
+				Prefix: aws.String("prefix/"),
+			},
+		},
+		{
+			Config: []interface{}{
+				map[string]interface{}{
+					"tags": map[string]interface{}{
+						"tag1key": "tag1value",
+					},
+				},
+			},
+			ExpectedS3AnalyticsFilter: &s3.AnalyticsFilter{
+				Tag: &s3.Tag{
+					Key:   aws.String("tag1key"),
+					Value: aws.String("tag1value"),
+				},
+			},
+		},
+		{
+			Config: []interface{}{
+				map[string]interface{}{
+					"prefix": "prefix/",
+					"tags": map[string]interface{}{
+						"tag1key": "tag1value",
+					},
+				},
+			},
+			ExpectedS3AnalyticsFilter: &s3.AnalyticsFilter{
+				And: &s3.AnalyticsAndOperator{
+					Prefix: aws.String("prefix/"),
+					Tags: []*s3.Tag{
+						{
+							Key:   aws.String("tag1key"),
+							Value: aws.String("tag1value"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		value := expandS3AnalyticsFilter(tc.Config)
+
+		if !reflect.DeepEqual(value, tc.ExpectedS3AnalyticsFilter) {
+			t.Errorf("Case #%d: Given:\n%s\n\nExpected:\n%s", i, value, tc.ExpectedS3AnalyticsFilter)
+		}
+	}
+}
+
+func TestResourceAwsS3BucketAnalyticsConfigurationParseID(t *testing.T) {
+	validIds := []string{
+		"foo:bar",
+		"my-bucket:entire-bucket",
+	}
+
+	for _, s := range validIds {
+		_, _, err := resourceAwsS3BucketAnalyticsConfigurationParseID(s)
+		if err != nil {
+			t.Fatalf("%s should be a valid S3 bucket analytics configuration id: %s", s, err)
+		}
+	}
+
+	invalidIds := []string{
+		"",
+		"foo",
+		"foo:bar:baz",
+	}
+
+	for _, s := range invalidIds {
+		_, _, err := resourceAwsS3BucketAnalyticsConfigurationParseID(s)
+		if err == nil {
+			t.Fatalf("%s should not be a valid S3 bucket analytics configuration id", s)
+		}
+	}
+}
+
+func TestAccAWSS3BucketAnalyticsConfiguration_basic(t *testing.T) {
+	var ac s3.AnalyticsConfiguration
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_s3_bucket_analytics_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketAnalyticsConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketAnalyticsConfiguration(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketAnalyticsConfigurationExists(resourceName, &ac),
+					resource.TestCheckResourceAttrPair(resourceName, "bucket", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "storage_class_analysis.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketAnalyticsConfiguration_WithFilter(t *testing.T) {
+	var ac s3.AnalyticsConfiguration
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_s3_bucket_analytics_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketAnalyticsConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketAnalyticsConfigurationWithFilterPrefix(rName, "prefix/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketAnalyticsConfigurationExists(resourceName, &ac),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.prefix", "prefix/"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSS3BucketAnalyticsConfigurationWithFilterPrefixAndTags(rName, "prefix/", "value1", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketAnalyticsConfigurationExists(resourceName, &ac),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.prefix", "prefix/"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.tags.tag1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "filter.0.tags.tag2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSS3BucketAnalyticsConfiguration(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketAnalyticsConfigurationExists(resourceName, &ac),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3BucketAnalyticsConfiguration_WithStorageClassAnalysis(t *testing.T) {
+	var ac s3.AnalyticsConfiguration
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_s3_bucket_analytics_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3BucketAnalyticsConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3BucketAnalyticsConfigurationWithStorageClassAnalysis(rName, "analytics/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketAnalyticsConfigurationExists(resourceName, &ac),
+					resource.TestCheckResourceAttr(resourceName, "storage_class_analysis.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class_analysis.0.data_export.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class_analysis.0.data_export.0.output_schema_version", "V_1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class_analysis.0.data_export.0.destination.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class_analysis.0.data_export.0.destination.0.s3_bucket_destination.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "storage_class_analysis.0.data_export.0.destination.0.s3_bucket_destination.0.bucket_arn", "aws_s3_bucket.destination", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "storage_class_analysis.0.data_export.0.destination.0.s3_bucket_destination.0.bucket_account_id", "data.aws_caller_identity.current", "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class_analysis.0.data_export.0.destination.0.s3_bucket_destination.0.format", "CSV"),
+					resource.TestCheckResourceAttr(resourceName, "storage_class_analysis.0.data_export.0.destination.0.s3_bucket_destination.0.prefix", "analytics/"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSS3BucketAnalyticsConfigurationWithStorageClassAnalysis(rName, "updated/"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3BucketAnalyticsConfigurationExists(resourceName, &ac),
+					resource.TestCheckResourceAttr(resourceName, "storage_class_analysis.0.data_export.0.destination.0.s3_bucket_destination.0.prefix", "updated/"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSS3BucketAnalyticsConfigurationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3_bucket_analytics_configuration" {
+			continue
+		}
+
+		bucket, name, err := resourceAwsS3BucketAnalyticsConfigurationParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		output, err := conn.GetBucketAnalyticsConfiguration(&s3.GetBucketAnalyticsConfigurationInput{
+			Bucket: aws.String(bucket),
+			Id:     aws.String(name),
+		})
+
+		if isAWSErr(err, s3.ErrCodeNoSuchBucket, "") || isAWSErr(err, "NoSuchConfiguration", "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if output != nil && output.AnalyticsConfiguration != nil {
+			return fmt.Errorf("S3 bucket analytics configuration (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSS3BucketAnalyticsConfigurationExists(n string, ac *s3.AnalyticsConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 bucket analytics configuration ID is set")
+		}
+
+		bucket, name, err := resourceAwsS3BucketAnalyticsConfigurationParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+		output, err := conn.GetBucketAnalyticsConfiguration(&s3.GetBucketAnalyticsConfigurationInput{
+			Bucket: aws.String(bucket),
+			Id:     aws.String(name),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil || output.AnalyticsConfiguration == nil {
+			return fmt.Errorf("S3 bucket analytics configuration (%s) not found", rs.Primary.ID)
+		}
+
+		*ac = *output.AnalyticsConfiguration
+
+		return nil
+	}
+}
+
+func testAccAWSS3BucketAnalyticsConfigurationConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSS3BucketAnalyticsConfiguration(rName string) string {
+	return testAccAWSS3BucketAnalyticsConfigurationConfigBase(rName) + fmt.Sprintf(`
+resource "aws_s3_bucket_analytics_configuration" "test" {
+  bucket = "${aws_s3_bucket.test.bucket}"
+  name   = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSS3BucketAnalyticsConfigurationWithFilterPrefix(rName, prefix string) string {
+	return testAccAWSS3BucketAnalyticsConfigurationConfigBase(rName) + fmt.Sprintf(`
+resource "aws_s3_bucket_analytics_configuration" "test" {
+  bucket = "${aws_s3_bucket.test.bucket}"
+  name   = %[1]q
+
+  filter {
+    prefix = %[2]q
+  }
+}
+`, rName, prefix)
+}
+
+func testAccAWSS3BucketAnalyticsConfigurationWithFilterPrefixAndTags(rName, prefix, tag1, tag2 string) string {
+	return testAccAWSS3BucketAnalyticsConfigurationConfigBase(rName) + fmt.Sprintf(`
+resource "aws_s3_bucket_analytics_configuration" "test" {
+  bucket = "${aws_s3_bucket.test.bucket}"
+  name   = %[1]q
+
+  filter {
+    prefix = %[2]q
+
+    tags = {
+      "tag1" = %[3]q
+      "tag2" = %[4]q
+    }
+  }
+}
+`, rName, prefix, tag1, tag2)
+}
+
+func testAccAWSS3BucketAnalyticsConfigurationWithStorageClassAnalysis(rName, prefix string) string {
+	return testAccAWSS3BucketAnalyticsConfigurationConfigBase(rName) + fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_s3_bucket" "destination" {
+  bucket = "%[1]s-destination"
+}
+
+resource "aws_s3_bucket_analytics_configuration" "test" {
+  bucket = "${aws_s3_bucket.test.bucket}"
+  name   = %[1]q
+
+  storage_class_analysis {
+    data_export {
+      destination {
+        s3_bucket_destination {
+          bucket_arn        = "${aws_s3_bucket.destination.arn}"
+          bucket_account_id = "${data.aws_caller_identity.current.account_id}"
+          prefix            = %[2]q
+        }
+      }
+    }
+  }
+}
+`, rName, prefix)
+}
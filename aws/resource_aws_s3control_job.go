@@ -0,0 +1,1026 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsS3ControlJob() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsS3ControlJobCreate,
+		Read:   resourceAwsS3ControlJobRead,
+		Update: resourceAwsS3ControlJobUpdate,
+		Delete: resourceAwsS3ControlJobDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"confirmation_required": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"confirmed": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"failure_reasons": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"failure_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"failure_reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"manifest": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"location": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"etag": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"object_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validateArn,
+									},
+									"object_version_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"spec": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"fields": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{
+												s3control.JobManifestFieldNameBucket,
+												s3control.JobManifestFieldNameIgnore,
+												s3control.JobManifestFieldNameKey,
+												s3control.JobManifestFieldNameVersionId,
+											}, false),
+										},
+									},
+									"format": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											s3control.JobManifestFormatS3batchOperationsCsv20180820,
+											s3control.JobManifestFormatS3inventoryReportCsv20161130,
+										}, false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"operation": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"lambda_invoke": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"function_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validateArn,
+									},
+								},
+							},
+						},
+						"s3_initiate_restore_object": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"expiration_in_days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"glacier_job_tier": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											s3control.S3GlacierJobTierBulk,
+											s3control.S3GlacierJobTierStandard,
+										}, false),
+									},
+								},
+							},
+						},
+						"s3_put_object_acl": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"canned_access_control_list": s3ControlJobCannedAccessControlListSchema(true),
+								},
+							},
+						},
+						"s3_put_object_copy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"canned_access_control_list": s3ControlJobCannedAccessControlListSchema(false),
+									"metadata_directive": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											s3control.S3MetadataDirectiveCopy,
+											s3control.S3MetadataDirectiveReplace,
+										}, false),
+									},
+									"new_object_tagging": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+									},
+									"redirect_location": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"requester_pays": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"sse_aws_kms_key_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"storage_class": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											s3control.S3StorageClassDeepArchive,
+											s3control.S3StorageClassGlacier,
+											s3control.S3StorageClassIntelligentTiering,
+											s3control.S3StorageClassOnezoneIa,
+											s3control.S3StorageClassStandard,
+											s3control.S3StorageClassStandardIa,
+										}, false),
+									},
+									"target_key_prefix": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"target_resource": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validateArn,
+									},
+								},
+							},
+						},
+						"s3_put_object_tagging": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"tag_set": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"progress_summary": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"number_of_tasks_failed": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"number_of_tasks_succeeded": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"total_number_of_tasks": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"report": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validateArn,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+						"format": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								s3control.JobReportFormatReportCsv20180820,
+							}, false),
+						},
+						"prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"report_scope": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								s3control.JobReportScopeAllTasks,
+								s3control.JobReportScopeFailedTasksOnly,
+							}, false),
+						},
+					},
+				},
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_update_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"suspended_cause": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"termination_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func s3ControlJobCannedAccessControlListSchema(required bool) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Required: required,
+		Optional: !required,
+		ForceNew: true,
+		ValidateFunc: validation.StringInSlice([]string{
+			s3control.S3CannedAccessControlListAuthenticatedRead,
+			s3control.S3CannedAccessControlListAwsExecRead,
+			s3control.S3CannedAccessControlListBucketOwnerFullControl,
+			s3control.S3CannedAccessControlListBucketOwnerRead,
+			s3control.S3CannedAccessControlListPrivate,
+			s3control.S3CannedAccessControlListPublicRead,
+			s3control.S3CannedAccessControlListPublicReadWrite,
+		}, false),
+	}
+}
+
+func resourceAwsS3ControlJobCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3controlconn
+
+	accountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+
+	input := &s3control.CreateJobInput{
+		AccountId:            aws.String(accountID),
+		ClientRequestToken:   aws.String(resource.UniqueId()),
+		ConfirmationRequired: aws.Bool(d.Get("confirmation_required").(bool)),
+		Manifest:             expandS3ControlJobManifest(d.Get("manifest").([]interface{})),
+		Operation:            expandS3ControlJobOperation(d.Get("operation").([]interface{})),
+		Priority:             aws.Int64(int64(d.Get("priority").(int))),
+		Report:               expandS3ControlJobReport(d.Get("report").([]interface{})),
+		RoleArn:              aws.String(d.Get("role_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating S3 Control Job: %s", input)
+	var output *s3control.CreateJobOutput
+	// IAM changes to the job role can take some time to propagate.
+	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		var err error
+		output, err = conn.CreateJob(input)
+
+		if isAWSErr(err, s3control.ErrCodeBadRequestException, "Unable to assume role") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("error creating S3 Control Job: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.JobId))
+	d.Set("account_id", accountID)
+
+	if err := s3ControlJobConfirmAndWait(conn, d, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	return resourceAwsS3ControlJobRead(d, meta)
+}
+
+func resourceAwsS3ControlJobRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3controlconn
+
+	accountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+
+	job, err := s3ControlDescribeJob(conn, accountID, d.Id())
+
+	if isAWSErr(err, s3control.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] S3 Control Job (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Control Job (%s): %s", d.Id(), err)
+	}
+
+	if job == nil {
+		log.Printf("[WARN] S3 Control Job (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("account_id", accountID)
+	d.Set("arn", job.JobArn)
+	d.Set("confirmation_required", job.ConfirmationRequired)
+	d.Set("description", job.Description)
+	d.Set("priority", job.Priority)
+	d.Set("role_arn", job.RoleArn)
+	d.Set("status", job.Status)
+	d.Set("status_update_reason", job.StatusUpdateReason)
+	d.Set("suspended_cause", job.SuspendedCause)
+
+	if job.CreationTime != nil {
+		d.Set("creation_time", aws.TimeValue(job.CreationTime).Format(time.RFC3339))
+	}
+
+	if job.TerminationDate != nil {
+		d.Set("termination_time", aws.TimeValue(job.TerminationDate).Format(time.RFC3339))
+	}
+
+	if err := d.Set("failure_reasons", flattenS3ControlJobFailureReasons(job.FailureReasons)); err != nil {
+		return fmt.Errorf("error setting failure_reasons: %s", err)
+	}
+
+	if err := d.Set("manifest", flattenS3ControlJobManifest(job.Manifest)); err != nil {
+		return fmt.Errorf("error setting manifest: %s", err)
+	}
+
+	if err := d.Set("operation", flattenS3ControlJobOperation(job.Operation)); err != nil {
+		return fmt.Errorf("error setting operation: %s", err)
+	}
+
+	if err := d.Set("progress_summary", flattenS3ControlJobProgressSummary(job.ProgressSummary)); err != nil {
+		return fmt.Errorf("error setting progress_summary: %s", err)
+	}
+
+	if err := d.Set("report", flattenS3ControlJobReport(job.Report)); err != nil {
+		return fmt.Errorf("error setting report: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsS3ControlJobUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3controlconn
+
+	if d.HasChange("priority") {
+		input := &s3control.UpdateJobPriorityInput{
+			AccountId: aws.String(d.Get("account_id").(string)),
+			JobId:     aws.String(d.Id()),
+			Priority:  aws.Int64(int64(d.Get("priority").(int))),
+		}
+
+		log.Printf("[DEBUG] Updating S3 Control Job priority: %s", input)
+		if _, err := conn.UpdateJobPriority(input); err != nil {
+			return fmt.Errorf("error updating S3 Control Job (%s) priority: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("confirmed") || d.HasChange("wait_for_completion") {
+		if err := s3ControlJobConfirmAndWait(conn, d, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsS3ControlJobRead(d, meta)
+}
+
+func resourceAwsS3ControlJobDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3controlconn
+
+	// Batch Operations jobs cannot be deleted. Cancel jobs that have not yet
+	// finished and let the service expire the job record.
+	input := &s3control.UpdateJobStatusInput{
+		AccountId:          aws.String(d.Get("account_id").(string)),
+		JobId:              aws.String(d.Id()),
+		RequestedJobStatus: aws.String(s3control.RequestedJobStatusCancelled),
+		StatusUpdateReason: aws.String("Cancelled by Terraform"),
+	}
+
+	log.Printf("[DEBUG] Cancelling S3 Control Job: %s", input)
+	_, err := conn.UpdateJobStatus(input)
+
+	if isAWSErr(err, s3control.ErrCodeNotFoundException, "") || isAWSErr(err, s3control.ErrCodeJobStatusException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error cancelling S3 Control Job (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// s3ControlJobConfirmAndWait confirms a job that requires confirmation, if
+// requested, and optionally waits for the job to run to completion.
+func s3ControlJobConfirmAndWait(conn *s3control.S3Control, d *schema.ResourceData, timeout time.Duration) error {
+	accountID := d.Get("account_id").(string)
+	confirmationRequired := d.Get("confirmation_required").(bool)
+	confirmed := d.Get("confirmed").(bool)
+
+	if confirmationRequired && confirmed {
+		// Jobs can only be confirmed once they have been prepared and are awaiting confirmation.
+		job, err := waitForS3ControlJobStatus(conn, accountID, d.Id(), []string{s3control.JobStatusSuspended, s3control.JobStatusReady, s3control.JobStatusActive, s3control.JobStatusComplete}, timeout)
+		if err != nil {
+			return fmt.Errorf("error waiting for S3 Control Job (%s) to await confirmation: %s", d.Id(), err)
+		}
+
+		if aws.StringValue(job.Status) == s3control.JobStatusSuspended {
+			input := &s3control.UpdateJobStatusInput{
+				AccountId:          aws.String(accountID),
+				JobId:              aws.String(d.Id()),
+				RequestedJobStatus: aws.String(s3control.RequestedJobStatusReady),
+			}
+
+			log.Printf("[DEBUG] Confirming S3 Control Job: %s", input)
+			if _, err := conn.UpdateJobStatus(input); err != nil {
+				return fmt.Errorf("error confirming S3 Control Job (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.Get("wait_for_completion").(bool) && (!confirmationRequired || confirmed) {
+		if _, err := waitForS3ControlJobStatus(conn, accountID, d.Id(), []string{s3control.JobStatusComplete}, timeout); err != nil {
+			return fmt.Errorf("error waiting for S3 Control Job (%s) completion: %s", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+func s3ControlDescribeJob(conn *s3control.S3Control, accountID, jobID string) (*s3control.JobDescriptor, error) {
+	output, err := conn.DescribeJob(&s3control.DescribeJobInput{
+		AccountId: aws.String(accountID),
+		JobId:     aws.String(jobID),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.Job, nil
+}
+
+func s3ControlJobStatusRefreshFunc(conn *s3control.S3Control, accountID, jobID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		job, err := s3ControlDescribeJob(conn, accountID, jobID)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if job == nil {
+			return nil, "", nil
+		}
+
+		status := aws.StringValue(job.Status)
+
+		switch status {
+		case s3control.JobStatusCancelled, s3control.JobStatusFailed:
+			var reasons []string
+			for _, failure := range job.FailureReasons {
+				reasons = append(reasons, fmt.Sprintf("%s: %s", aws.StringValue(failure.FailureCode), aws.StringValue(failure.FailureReason)))
+			}
+			return job, status, fmt.Errorf("job %s: %s", strings.ToLower(status), strings.Join(reasons, ", "))
+		}
+
+		return job, status, nil
+	}
+}
+
+func waitForS3ControlJobStatus(conn *s3control.S3Control, accountID, jobID string, target []string, timeout time.Duration) (*s3control.JobDescriptor, error) {
+	var pending []string
+	for _, status := range []string{
+		s3control.JobStatusActive,
+		s3control.JobStatusCancelling,
+		s3control.JobStatusCompleting,
+		s3control.JobStatusFailing,
+		s3control.JobStatusNew,
+		s3control.JobStatusPaused,
+		s3control.JobStatusPausing,
+		s3control.JobStatusPreparing,
+		s3control.JobStatusReady,
+		s3control.JobStatusSuspended,
+	} {
+		isTarget := false
+		for _, t := range target {
+			if status == t {
+				isTarget = true
+				break
+			}
+		}
+
+		if !isTarget {
+			pending = append(pending, status)
+		}
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: pending,
+		Target:  target,
+		Refresh: s3ControlJobStatusRefreshFunc(conn, accountID, jobID),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if job, ok := outputRaw.(*s3control.JobDescriptor); ok {
+		return job, err
+	}
+
+	return nil, err
+}
+
+func expandS3ControlJobManifest(l []interface{}) *s3control.JobManifest {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	manifest := &s3control.JobManifest{
+		Location: &s3control.JobManifestLocation{},
+		Spec:     &s3control.JobManifestSpec{},
+	}
+
+	if v, ok := m["location"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		location := v[0].(map[string]interface{})
+
+		manifest.Location.ETag = aws.String(location["etag"].(string))
+		manifest.Location.ObjectArn = aws.String(location["object_arn"].(string))
+
+		if v, ok := location["object_version_id"].(string); ok && v != "" {
+			manifest.Location.ObjectVersionId = aws.String(v)
+		}
+	}
+
+	if v, ok := m["spec"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		spec := v[0].(map[string]interface{})
+
+		manifest.Spec.Format = aws.String(spec["format"].(string))
+
+		if v, ok := spec["fields"].([]interface{}); ok && len(v) > 0 {
+			manifest.Spec.Fields = expandStringList(v)
+		}
+	}
+
+	return manifest
+}
+
+func expandS3ControlJobOperation(l []interface{}) *s3control.JobOperation {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	operation := &s3control.JobOperation{}
+
+	if v, ok := m["lambda_invoke"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		operation.LambdaInvoke = &s3control.LambdaInvokeOperation{
+			FunctionArn: aws.String(tfMap["function_arn"].(string)),
+		}
+	}
+
+	if v, ok := m["s3_initiate_restore_object"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		operation.S3InitiateRestoreObject = &s3control.S3InitiateRestoreObjectOperation{}
+
+		if v, ok := tfMap["expiration_in_days"].(int); ok && v > 0 {
+			operation.S3InitiateRestoreObject.ExpirationInDays = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["glacier_job_tier"].(string); ok && v != "" {
+			operation.S3InitiateRestoreObject.GlacierJobTier = aws.String(v)
+		}
+	}
+
+	if v, ok := m["s3_put_object_acl"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		operation.S3PutObjectAcl = &s3control.S3SetObjectAclOperation{
+			AccessControlPolicy: &s3control.S3AccessControlPolicy{
+				CannedAccessControlList: aws.String(tfMap["canned_access_control_list"].(string)),
+			},
+		}
+	}
+
+	if v, ok := m["s3_put_object_copy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		operation.S3PutObjectCopy = &s3control.S3CopyObjectOperation{
+			TargetResource: aws.String(tfMap["target_resource"].(string)),
+		}
+
+		if v, ok := tfMap["canned_access_control_list"].(string); ok && v != "" {
+			operation.S3PutObjectCopy.CannedAccessControlList = aws.String(v)
+		}
+
+		if v, ok := tfMap["metadata_directive"].(string); ok && v != "" {
+			operation.S3PutObjectCopy.MetadataDirective = aws.String(v)
+		}
+
+		if v, ok := tfMap["new_object_tagging"].(map[string]interface{}); ok && len(v) > 0 {
+			operation.S3PutObjectCopy.NewObjectTagging = expandS3ControlJobTags(v)
+		}
+
+		if v, ok := tfMap["redirect_location"].(string); ok && v != "" {
+			operation.S3PutObjectCopy.RedirectLocation = aws.String(v)
+		}
+
+		if v, ok := tfMap["requester_pays"].(bool); ok && v {
+			operation.S3PutObjectCopy.RequesterPays = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["sse_aws_kms_key_id"].(string); ok && v != "" {
+			operation.S3PutObjectCopy.SSEAwsKmsKeyId = aws.String(v)
+		}
+
+		if v, ok := tfMap["storage_class"].(string); ok && v != "" {
+			operation.S3PutObjectCopy.StorageClass = aws.String(v)
+		}
+
+		if v, ok := tfMap["target_key_prefix"].(string); ok && v != "" {
+			operation.S3PutObjectCopy.TargetKeyPrefix = aws.String(v)
+		}
+	}
+
+	if v, ok := m["s3_put_object_tagging"].([]interface{}); ok && len(v) > 0 {
+		operation.S3PutObjectTagging = &s3control.S3SetObjectTaggingOperation{
+			TagSet: []*s3control.S3Tag{},
+		}
+
+		// An empty tag set removes all tags from the objects.
+		if v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			if v, ok := tfMap["tag_set"].(map[string]interface{}); ok {
+				operation.S3PutObjectTagging.TagSet = expandS3ControlJobTags(v)
+			}
+		}
+	}
+
+	return operation
+}
+
+func expandS3ControlJobReport(l []interface{}) *s3control.JobReport {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	report := &s3control.JobReport{
+		Enabled: aws.Bool(m["enabled"].(bool)),
+	}
+
+	if v, ok := m["bucket"].(string); ok && v != "" {
+		report.Bucket = aws.String(v)
+	}
+
+	if v, ok := m["format"].(string); ok && v != "" {
+		report.Format = aws.String(v)
+	}
+
+	if v, ok := m["prefix"].(string); ok && v != "" {
+		report.Prefix = aws.String(v)
+	}
+
+	if v, ok := m["report_scope"].(string); ok && v != "" {
+		report.ReportScope = aws.String(v)
+	}
+
+	return report
+}
+
+func expandS3ControlJobTags(m map[string]interface{}) []*s3control.S3Tag {
+	tags := make([]*s3control.S3Tag, 0, len(m))
+
+	for k, v := range m {
+		tags = append(tags, &s3control.S3Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v.(string)),
+		})
+	}
+
+	return tags
+}
+
+func flattenS3ControlJobTags(tags []*s3control.S3Tag) map[string]interface{} {
+	m := make(map[string]interface{}, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+
+	return m
+}
+
+func flattenS3ControlJobFailureReasons(failures []*s3control.JobFailure) []interface{} {
+	l := make([]interface{}, 0, len(failures))
+
+	for _, failure := range failures {
+		if failure == nil {
+			continue
+		}
+
+		l = append(l, map[string]interface{}{
+			"failure_code":   aws.StringValue(failure.FailureCode),
+			"failure_reason": aws.StringValue(failure.FailureReason),
+		})
+	}
+
+	return l
+}
+
+func flattenS3ControlJobManifest(manifest *s3control.JobManifest) []interface{} {
+	if manifest == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	if location := manifest.Location; location != nil {
+		m["location"] = []interface{}{
+			map[string]interface{}{
+				"etag":              aws.StringValue(location.ETag),
+				"object_arn":        aws.StringValue(location.ObjectArn),
+				"object_version_id": aws.StringValue(location.ObjectVersionId),
+			},
+		}
+	}
+
+	if spec := manifest.Spec; spec != nil {
+		m["spec"] = []interface{}{
+			map[string]interface{}{
+				"fields": flattenStringList(spec.Fields),
+				"format": aws.StringValue(spec.Format),
+			},
+		}
+	}
+
+	return []interface{}{m}
+}
+
+func flattenS3ControlJobOperation(operation *s3control.JobOperation) []interface{} {
+	if operation == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	if v := operation.LambdaInvoke; v != nil {
+		m["lambda_invoke"] = []interface{}{
+			map[string]interface{}{
+				"function_arn": aws.StringValue(v.FunctionArn),
+			},
+		}
+	}
+
+	if v := operation.S3InitiateRestoreObject; v != nil {
+		m["s3_initiate_restore_object"] = []interface{}{
+			map[string]interface{}{
+				"expiration_in_days": int(aws.Int64Value(v.ExpirationInDays)),
+				"glacier_job_tier":   aws.StringValue(v.GlacierJobTier),
+			},
+		}
+	}
+
+	if v := operation.S3PutObjectAcl; v != nil && v.AccessControlPolicy != nil {
+		m["s3_put_object_acl"] = []interface{}{
+			map[string]interface{}{
+				"canned_access_control_list": aws.StringValue(v.AccessControlPolicy.CannedAccessControlList),
+			},
+		}
+	}
+
+	if v := operation.S3PutObjectCopy; v != nil {
+		m["s3_put_object_copy"] = []interface{}{
+			map[string]interface{}{
+				"canned_access_control_list": aws.StringValue(v.CannedAccessControlList),
+				"metadata_directive":         aws.StringValue(v.MetadataDirective),
+				"new_object_tagging":         flattenS3ControlJobTags(v.NewObjectTagging),
+				"redirect_location":          aws.StringValue(v.RedirectLocation),
+				"requester_pays":             aws.BoolValue(v.RequesterPays),
+				"sse_aws_kms_key_id":         aws.StringValue(v.SSEAwsKmsKeyId),
+				"storage_class":              aws.StringValue(v.StorageClass),
+				"target_key_prefix":          aws.StringValue(v.TargetKeyPrefix),
+				"target_resource":            aws.StringValue(v.TargetResource),
+			},
+		}
+	}
+
+	if v := operation.S3PutObjectTagging; v != nil {
+		m["s3_put_object_tagging"] = []interface{}{
+			map[string]interface{}{
+				"tag_set": flattenS3ControlJobTags(v.TagSet),
+			},
+		}
+	}
+
+	return []interface{}{m}
+}
+
+func flattenS3ControlJobProgressSummary(summary *s3control.JobProgressSummary) []interface{} {
+	if summary == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"number_of_tasks_failed":    int(aws.Int64Value(summary.NumberOfTasksFailed)),
+			"number_of_tasks_succeeded": int(aws.Int64Value(summary.NumberOfTasksSucceeded)),
+			"total_number_of_tasks":     int(aws.Int64Value(summary.TotalNumberOfTasks)),
+		},
+	}
+}
+
+func flattenS3ControlJobReport(report *s3control.JobReport) []interface{} {
+	if report == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"bucket":       aws.StringValue(report.Bucket),
+			"enabled":      aws.BoolValue(report.Enabled),
+			"format":       aws.StringValue(report.Format),
+			"prefix":       aws.StringValue(report.Prefix),
+			"report_scope": aws.StringValue(report.ReportScope),
+		},
+	}
+}
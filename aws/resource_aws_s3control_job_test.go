@@ -0,0 +1,295 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSS3ControlJob_basic(t *testing.T) {
+	var job s3control.JobDescriptor
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_s3control_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3ControlJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3ControlJobConfig(rName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ControlJobExists(resourceName, &job),
+					testAccCheckResourceAttrAccountID(resourceName, "account_id"),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "s3", regexp.MustCompile(`job/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "confirmation_required", "false"),
+					resource.TestCheckResourceAttr(resourceName, "description", rName),
+					resource.TestCheckResourceAttr(resourceName, "manifest.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "manifest.0.spec.0.format", "S3BatchOperations_CSV_20180820"),
+					resource.TestCheckResourceAttr(resourceName, "operation.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.s3_put_object_tagging.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.s3_put_object_tagging.0.tag_set.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.s3_put_object_tagging.0.tag_set.Key1", "Value1"),
+					resource.TestCheckResourceAttr(resourceName, "priority", "10"),
+					resource.TestCheckResourceAttr(resourceName, "report.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "report.0.enabled", "false"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"confirmed", "progress_summary", "status", "wait_for_completion"},
+			},
+			{
+				Config: testAccAWSS3ControlJobConfig(rName, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ControlJobExists(resourceName, &job),
+					resource.TestCheckResourceAttr(resourceName, "priority", "20"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSS3ControlJob_ConfirmationRequired(t *testing.T) {
+	var job s3control.JobDescriptor
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_s3control_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSS3ControlJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSS3ControlJobConfigConfirmationRequired(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ControlJobExists(resourceName, &job),
+					resource.TestCheckResourceAttr(resourceName, "confirmation_required", "true"),
+					resource.TestCheckResourceAttr(resourceName, "confirmed", "false"),
+				),
+			},
+			{
+				Config: testAccAWSS3ControlJobConfigConfirmationRequired(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSS3ControlJobExists(resourceName, &job),
+					resource.TestCheckResourceAttr(resourceName, "confirmed", "true"),
+					resource.TestCheckResourceAttr(resourceName, "status", s3control.JobStatusComplete),
+					resource.TestCheckResourceAttr(resourceName, "progress_summary.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "progress_summary.0.number_of_tasks_succeeded", "1"),
+					resource.TestCheckResourceAttr(resourceName, "progress_summary.0.total_number_of_tasks", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSS3ControlJobExists(resourceName string, job *s3control.JobDescriptor) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 Control Job ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).s3controlconn
+
+		output, err := s3ControlDescribeJob(conn, rs.Primary.Attributes["account_id"], rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output == nil {
+			return fmt.Errorf("S3 Control Job (%s) not found", rs.Primary.ID)
+		}
+
+		*job = *output
+
+		return nil
+	}
+}
+
+// Batch Operations jobs cannot be deleted, so destroyed jobs are expected to
+// have been cancelled or to have already finished.
+func testAccCheckAWSS3ControlJobDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).s3controlconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3control_job" {
+			continue
+		}
+
+		job, err := s3ControlDescribeJob(conn, rs.Primary.Attributes["account_id"], rs.Primary.ID)
+
+		if isAWSErr(err, s3control.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if job == nil {
+			continue
+		}
+
+		switch aws.StringValue(job.Status) {
+		case s3control.JobStatusCancelled, s3control.JobStatusCancelling, s3control.JobStatusComplete, s3control.JobStatusFailed:
+			continue
+		}
+
+		return fmt.Errorf("S3 Control Job (%s) still active with status: %s", rs.Primary.ID, aws.StringValue(job.Status))
+	}
+
+	return nil
+}
+
+func testAccAWSS3ControlJobConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_object" "object" {
+  bucket  = "${aws_s3_bucket.test.id}"
+  key     = "object"
+  content = "test"
+}
+
+resource "aws_s3_bucket_object" "manifest" {
+  bucket  = "${aws_s3_bucket.test.id}"
+  key     = "manifest.csv"
+  content = "${aws_s3_bucket.test.id},${aws_s3_bucket_object.object.key}\n"
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "batchoperations.s3.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = "${aws_iam_role.test.id}"
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "s3:GetObject",
+        "s3:GetObjectVersion",
+        "s3:PutObjectTagging",
+        "s3:PutObjectVersionTagging"
+      ],
+      "Resource": "${aws_s3_bucket.test.arn}/*"
+    }
+  ]
+}
+EOF
+}
+`, rName)
+}
+
+func testAccAWSS3ControlJobConfig(rName string, priority int) string {
+	return testAccAWSS3ControlJobConfigBase(rName) + fmt.Sprintf(`
+resource "aws_s3control_job" "test" {
+  description = %[1]q
+  priority    = %[2]d
+  role_arn    = "${aws_iam_role.test.arn}"
+
+  manifest {
+    location {
+      etag       = "${aws_s3_bucket_object.manifest.etag}"
+      object_arn = "${aws_s3_bucket.test.arn}/${aws_s3_bucket_object.manifest.key}"
+    }
+
+    spec {
+      fields = ["Bucket", "Key"]
+      format = "S3BatchOperations_CSV_20180820"
+    }
+  }
+
+  operation {
+    s3_put_object_tagging {
+      tag_set = {
+        Key1 = "Value1"
+      }
+    }
+  }
+
+  report {
+    enabled = false
+  }
+
+  depends_on = ["aws_iam_role_policy.test"]
+}
+`, rName, priority)
+}
+
+func testAccAWSS3ControlJobConfigConfirmationRequired(rName string, confirmed bool) string {
+	return testAccAWSS3ControlJobConfigBase(rName) + fmt.Sprintf(`
+resource "aws_s3control_job" "test" {
+  confirmation_required = true
+  confirmed             = %[1]t
+  priority              = 10
+  role_arn              = "${aws_iam_role.test.arn}"
+  wait_for_completion   = true
+
+  manifest {
+    location {
+      etag       = "${aws_s3_bucket_object.manifest.etag}"
+      object_arn = "${aws_s3_bucket.test.arn}/${aws_s3_bucket_object.manifest.key}"
+    }
+
+    spec {
+      fields = ["Bucket", "Key"]
+      format = "S3BatchOperations_CSV_20180820"
+    }
+  }
+
+  operation {
+    s3_put_object_tagging {
+      tag_set = {
+        Key1 = "Value1"
+      }
+    }
+  }
+
+  report {
+    enabled = false
+  }
+
+  depends_on = ["aws_iam_role_policy.test"]
+}
+`, confirmed)
+}
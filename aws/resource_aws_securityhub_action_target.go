@@ -0,0 +1,171 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsSecurityHubActionTarget() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSecurityHubActionTargetCreate,
+		Read:   resourceAwsSecurityHubActionTargetRead,
+		Update: resourceAwsSecurityHubActionTargetUpdate,
+		Delete: resourceAwsSecurityHubActionTargetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 20),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9]+$`), "must contain only alphanumeric characters"),
+				),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 20),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsSecurityHubActionTargetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).securityhubconn
+
+	input := &securityhub.CreateActionTargetInput{
+		Description: aws.String(d.Get("description").(string)),
+		Id:          aws.String(d.Get("identifier").(string)),
+		Name:        aws.String(d.Get("name").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating Security Hub custom action target: %s", input)
+	resp, err := conn.CreateActionTarget(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Security Hub custom action target %s: %s", d.Get("identifier").(string), err)
+	}
+
+	d.SetId(aws.StringValue(resp.ActionTargetArn))
+
+	return resourceAwsSecurityHubActionTargetRead(d, meta)
+}
+
+func resourceAwsSecurityHubActionTargetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).securityhubconn
+
+	log.Printf("[DEBUG] Reading Security Hub custom action target %s", d.Id())
+
+	actionTarget, err := securityHubDescribeActionTarget(conn, d.Id())
+
+	if isAWSErr(err, securityhub.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Security Hub custom action target (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Security Hub custom action target (%s): %s", d.Id(), err)
+	}
+
+	if actionTarget == nil {
+		log.Printf("[WARN] Security Hub custom action target (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	identifier, err := resourceAwsSecurityHubActionTargetParseIdentifier(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	d.Set("arn", actionTarget.ActionTargetArn)
+	d.Set("description", actionTarget.Description)
+	d.Set("identifier", identifier)
+	d.Set("name", actionTarget.Name)
+
+	return nil
+}
+
+func resourceAwsSecurityHubActionTargetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).securityhubconn
+
+	input := &securityhub.UpdateActionTargetInput{
+		ActionTargetArn: aws.String(d.Id()),
+		Description:     aws.String(d.Get("description").(string)),
+		Name:            aws.String(d.Get("name").(string)),
+	}
+
+	log.Printf("[DEBUG] Updating Security Hub custom action target: %s", input)
+	if _, err := conn.UpdateActionTarget(input); err != nil {
+		return fmt.Errorf("error updating Security Hub custom action target (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsSecurityHubActionTargetRead(d, meta)
+}
+
+func resourceAwsSecurityHubActionTargetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).securityhubconn
+
+	log.Printf("[DEBUG] Deleting Security Hub custom action target %s", d.Id())
+
+	_, err := conn.DeleteActionTarget(&securityhub.DeleteActionTargetInput{
+		ActionTargetArn: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, securityhub.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Security Hub custom action target (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func securityHubDescribeActionTarget(conn *securityhub.SecurityHub, arn string) (*securityhub.ActionTarget, error) {
+	resp, err := conn.DescribeActionTargets(&securityhub.DescribeActionTargetsInput{
+		ActionTargetArns: []*string{aws.String(arn)},
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resp == nil || len(resp.ActionTargets) == 0 {
+		return nil, nil
+	}
+
+	return resp.ActionTargets[0], nil
+}
+
+func resourceAwsSecurityHubActionTargetParseIdentifier(arn string) (string, error) {
+	parts := strings.Split(arn, "/")
+
+	if len(parts) != 3 {
+		return "", fmt.Errorf("Expected Security Hub Custom action ARN, received: %s", arn)
+	}
+
+	return parts[2], nil
+}
@@ -0,0 +1,107 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func testAccAWSSecurityHubActionTarget_basic(t *testing.T) {
+	resourceName := "aws_securityhub_action_target.example"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSecurityHubActionTargetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecurityHubActionTargetConfig("Test action", "This is a test custom action"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityHubActionTargetExists(resourceName),
+					testAccCheckResourceAttrRegionalARN(resourceName, "arn", "securityhub", "action/custom/testaction"),
+					resource.TestCheckResourceAttr(resourceName, "description", "This is a test custom action"),
+					resource.TestCheckResourceAttr(resourceName, "identifier", "testaction"),
+					resource.TestCheckResourceAttr(resourceName, "name", "Test action"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSecurityHubActionTargetConfig("Updated action", "This is an updated custom action"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityHubActionTargetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "This is an updated custom action"),
+					resource.TestCheckResourceAttr(resourceName, "name", "Updated action"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSecurityHubActionTargetExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).securityhubconn
+
+		actionTarget, err := securityHubDescribeActionTarget(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if actionTarget == nil {
+			return fmt.Errorf("Security Hub custom action %s not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSSecurityHubActionTargetDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).securityhubconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_securityhub_action_target" {
+			continue
+		}
+
+		actionTarget, err := securityHubDescribeActionTarget(conn, rs.Primary.ID)
+
+		if isAWSErr(err, securityhub.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if actionTarget != nil {
+			return fmt.Errorf("Security Hub custom action %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSSecurityHubActionTargetConfig(name, description string) string {
+	return fmt.Sprintf(`
+resource "aws_securityhub_account" "example" {}
+
+resource "aws_securityhub_action_target" "example" {
+  depends_on  = ["aws_securityhub_account.example"]
+  name        = %[1]q
+  identifier  = "testaction"
+  description = %[2]q
+}
+`, name, description)
+}
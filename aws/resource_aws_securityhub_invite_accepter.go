@@ -0,0 +1,135 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsSecurityHubInviteAccepter() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSecurityHubInviteAccepterCreate,
+		Read:   resourceAwsSecurityHubInviteAccepterRead,
+		Delete: resourceAwsSecurityHubInviteAccepterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"master_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"invitation_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsSecurityHubInviteAccepterCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).securityhubconn
+	masterID := d.Get("master_id").(string)
+
+	invitationID, err := securityHubFindInvitationID(conn, masterID)
+
+	if err != nil {
+		return fmt.Errorf("error listing Security Hub invitations: %s", err)
+	}
+
+	if invitationID == "" {
+		return fmt.Errorf("unable to find pending Security Hub invitation from master account ID (%s)", masterID)
+	}
+
+	input := &securityhub.AcceptInvitationInput{
+		InvitationId: aws.String(invitationID),
+		MasterId:     aws.String(masterID),
+	}
+
+	log.Printf("[DEBUG] Accepting Security Hub invitation: %s", input)
+	if _, err := conn.AcceptInvitation(input); err != nil {
+		return fmt.Errorf("error accepting Security Hub invitation (%s): %s", invitationID, err)
+	}
+
+	d.SetId(meta.(*AWSClient).accountid)
+
+	return resourceAwsSecurityHubInviteAccepterRead(d, meta)
+}
+
+func resourceAwsSecurityHubInviteAccepterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).securityhubconn
+
+	log.Printf("[DEBUG] Reading Security Hub master account")
+	resp, err := conn.GetMasterAccount(&securityhub.GetMasterAccountInput{})
+
+	if isAWSErr(err, securityhub.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Security Hub master account not found for (%s), removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Security Hub master account: %s", err)
+	}
+
+	if resp == nil || resp.Master == nil {
+		log.Printf("[WARN] Security Hub master account not found for (%s), removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("invitation_id", resp.Master.InvitationId)
+	d.Set("master_id", resp.Master.AccountId)
+
+	return nil
+}
+
+func resourceAwsSecurityHubInviteAccepterDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).securityhubconn
+
+	log.Printf("[DEBUG] Disassociating Security Hub account (%s) from master account", d.Id())
+	_, err := conn.DisassociateFromMasterAccount(&securityhub.DisassociateFromMasterAccountInput{})
+
+	if isAWSErr(err, securityhub.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error disassociating Security Hub account (%s) from master account: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func securityHubFindInvitationID(conn *securityhub.SecurityHub, masterID string) (string, error) {
+	input := &securityhub.ListInvitationsInput{}
+
+	for {
+		log.Printf("[DEBUG] Listing Security Hub invitations: %s", input)
+		resp, err := conn.ListInvitations(input)
+
+		if err != nil {
+			return "", err
+		}
+
+		for _, invitation := range resp.Invitations {
+			if aws.StringValue(invitation.AccountId) == masterID {
+				return aws.StringValue(invitation.InvitationId), nil
+			}
+		}
+
+		if aws.StringValue(resp.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = resp.NextToken
+	}
+
+	return "", nil
+}
@@ -0,0 +1,126 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func testAccAWSSecurityHubInviteAccepter_basic(t *testing.T) {
+	var providers []*schema.Provider
+	resourceName := "aws_securityhub_invite_accepter.test"
+	_, email := testAccAWSSecurityHubMemberFromEnv(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccAlternateAccountPreCheck(t)
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckAWSSecurityHubInviteAccepterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecurityHubInviteAccepterConfig(email),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityHubInviteAccepterExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "master_id", "data.aws_caller_identity.master", "account_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "invitation_id"),
+				),
+			},
+			{
+				Config:            testAccAWSSecurityHubInviteAccepterConfig(email),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSecurityHubInviteAccepterExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).securityhubconn
+
+		resp, err := conn.GetMasterAccount(&securityhub.GetMasterAccountInput{})
+
+		if err != nil {
+			return err
+		}
+
+		if resp == nil || resp.Master == nil {
+			return fmt.Errorf("no Security Hub master account found for: %s", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSSecurityHubInviteAccepterDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).securityhubconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_securityhub_invite_accepter" {
+			continue
+		}
+
+		resp, err := conn.GetMasterAccount(&securityhub.GetMasterAccountInput{})
+
+		if isAWSErr(err, securityhub.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		// Can only read the master account if Security Hub is enabled
+		if isAWSErr(err, securityhub.ErrCodeInvalidAccessException, "not subscribed to AWS Security Hub") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if resp != nil && resp.Master != nil {
+			return fmt.Errorf("Security Hub account (%s) still has a master account", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSSecurityHubInviteAccepterConfig(email string) string {
+	return testAccAlternateAccountProviderConfig() + fmt.Sprintf(`
+data "aws_caller_identity" "master" {
+  provider = "aws.alternate"
+}
+
+data "aws_caller_identity" "member" {}
+
+resource "aws_securityhub_account" "master" {
+  provider = "aws.alternate"
+}
+
+resource "aws_securityhub_account" "member" {}
+
+resource "aws_securityhub_member" "member" {
+  provider = "aws.alternate"
+
+  depends_on = ["aws_securityhub_account.master"]
+  account_id = "${data.aws_caller_identity.member.account_id}"
+  email      = %[1]q
+  invite     = true
+}
+
+resource "aws_securityhub_invite_accepter" "test" {
+  depends_on = ["aws_securityhub_account.member", "aws_securityhub_member.member"]
+  master_id  = "${data.aws_caller_identity.master.account_id}"
+}
+`, email)
+}
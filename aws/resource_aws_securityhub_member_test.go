@@ -0,0 +1,129 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func testAccAWSSecurityHubMember_basic(t *testing.T) {
+	resourceName := "aws_securityhub_member.example"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSecurityHubMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecurityHubMemberConfig("111111111111", "example@example.com", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityHubMemberExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "account_id", "111111111111"),
+					resource.TestCheckResourceAttr(resourceName, "email", "example@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "invite", "false"),
+					resource.TestCheckResourceAttr(resourceName, "member_status", "Created"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAWSSecurityHubMember_invite(t *testing.T) {
+	resourceName := "aws_securityhub_member.example"
+	accountID, email := testAccAWSSecurityHubMemberFromEnv(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSecurityHubMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecurityHubMemberConfig(accountID, email, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityHubMemberExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "invite", "true"),
+					resource.TestCheckResourceAttr(resourceName, "member_status", "Invited"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSecurityHubMemberExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).securityhubconn
+
+		resp, err := conn.GetMembers(&securityhub.GetMembersInput{
+			AccountIds: []*string{aws.String(rs.Primary.ID)},
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if len(resp.Members) == 0 {
+			return fmt.Errorf("Security Hub member %s not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSSecurityHubMemberDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).securityhubconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_securityhub_member" {
+			continue
+		}
+
+		resp, err := conn.GetMembers(&securityhub.GetMembersInput{
+			AccountIds: []*string{aws.String(rs.Primary.ID)},
+		})
+
+		if err != nil {
+			if isAWSErr(err, securityhub.ErrCodeResourceNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		if len(resp.Members) != 0 {
+			return fmt.Errorf("Security Hub member %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSSecurityHubMemberConfig(accountID, email string, invite bool) string {
+	return fmt.Sprintf(`
+resource "aws_securityhub_account" "example" {}
+
+resource "aws_securityhub_member" "example" {
+  depends_on = ["aws_securityhub_account.example"]
+  account_id = %[1]q
+  email      = %[2]q
+  invite     = %[3]t
+}
+`, accountID, email, invite)
+}
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"os"
 	"testing"
 )
 
@@ -9,6 +10,16 @@ func TestAccAWSSecurityHub(t *testing.T) {
 		"Account": {
 			"basic": testAccAWSSecurityHubAccount_basic,
 		},
+		"ActionTarget": {
+			"basic": testAccAWSSecurityHubActionTarget_basic,
+		},
+		"InviteAccepter": {
+			"basic": testAccAWSSecurityHubInviteAccepter_basic,
+		},
+		"Member": {
+			"basic":  testAccAWSSecurityHubMember_basic,
+			"invite": testAccAWSSecurityHubMember_invite,
+		},
 		"ProductSubscription": {
 			"basic": testAccAWSSecurityHubProductSubscription_basic,
 		},
@@ -29,3 +40,21 @@ func TestAccAWSSecurityHub(t *testing.T) {
 		})
 	}
 }
+
+func testAccAWSSecurityHubMemberFromEnv(t *testing.T) (string, string) {
+	accountID := os.Getenv("AWS_SECURITYHUB_MEMBER_ACCOUNT_ID")
+	if accountID == "" {
+		t.Skip(
+			"Environment variable AWS_SECURITYHUB_MEMBER_ACCOUNT_ID is not set. " +
+				"To properly test inviting Security Hub member accounts, " +
+				"a valid AWS account ID must be provided.")
+	}
+	email := os.Getenv("AWS_SECURITYHUB_MEMBER_EMAIL")
+	if email == "" {
+		t.Skip(
+			"Environment variable AWS_SECURITYHUB_MEMBER_EMAIL is not set. " +
+				"To properly test inviting Security Hub member accounts, " +
+				"a valid email associated with the AWS_SECURITYHUB_MEMBER_ACCOUNT_ID must be provided.")
+	}
+	return accountID, email
+}
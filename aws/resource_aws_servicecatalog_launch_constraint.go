@@ -0,0 +1,225 @@
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	serviceCatalogConstraintTypeLaunch   = "LAUNCH"
+	serviceCatalogConstraintTypeTemplate = "TEMPLATE"
+)
+
+type serviceCatalogLaunchConstraintParameters struct {
+	LocalRoleName string `json:",omitempty"`
+	RoleArn       string `json:",omitempty"`
+}
+
+func resourceAwsServiceCatalogLaunchConstraint() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogLaunchConstraintCreate,
+		Read:   resourceAwsServiceCatalogLaunchConstraintRead,
+		Update: resourceAwsServiceCatalogLaunchConstraintUpdate,
+		Delete: resourceAwsServiceCatalogConstraintDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsServiceCatalogConstraintImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"local_role_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"role_arn"},
+			},
+			"owner": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"portfolio_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"product_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"role_arn": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validateArn,
+				ConflictsWith: []string{"local_role_name"},
+			},
+		},
+	}
+}
+
+func resourceAwsServiceCatalogLaunchConstraintCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	parameters, err := expandServiceCatalogLaunchConstraintParameters(d)
+	if err != nil {
+		return err
+	}
+
+	input := &servicecatalog.CreateConstraintInput{
+		AcceptLanguage:   aws.String("en"),
+		IdempotencyToken: aws.String(resource.UniqueId()),
+		Parameters:       aws.String(parameters),
+		PortfolioId:      aws.String(d.Get("portfolio_id").(string)),
+		ProductId:        aws.String(d.Get("product_id").(string)),
+		Type:             aws.String(serviceCatalogConstraintTypeLaunch),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Service Catalog Launch Constraint: %s", input)
+	output, err := conn.CreateConstraint(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Service Catalog Launch Constraint: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.ConstraintDetail.ConstraintId))
+
+	return resourceAwsServiceCatalogLaunchConstraintRead(d, meta)
+}
+
+func resourceAwsServiceCatalogLaunchConstraintRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	output, err := serviceCatalogDescribeConstraint(conn, d.Id())
+
+	if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Service Catalog Launch Constraint (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Service Catalog Launch Constraint (%s): %s", d.Id(), err)
+	}
+
+	var parameters serviceCatalogLaunchConstraintParameters
+	if err := json.Unmarshal([]byte(aws.StringValue(output.ConstraintParameters)), &parameters); err != nil {
+		return fmt.Errorf("error parsing Service Catalog Launch Constraint (%s) parameters: %s", d.Id(), err)
+	}
+
+	d.Set("description", output.ConstraintDetail.Description)
+	d.Set("local_role_name", parameters.LocalRoleName)
+	d.Set("owner", output.ConstraintDetail.Owner)
+	d.Set("role_arn", parameters.RoleArn)
+
+	return nil
+}
+
+func resourceAwsServiceCatalogLaunchConstraintUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	parameters, err := expandServiceCatalogLaunchConstraintParameters(d)
+	if err != nil {
+		return err
+	}
+
+	input := &servicecatalog.UpdateConstraintInput{
+		AcceptLanguage: aws.String("en"),
+		Description:    aws.String(d.Get("description").(string)),
+		Id:             aws.String(d.Id()),
+		Parameters:     aws.String(parameters),
+	}
+
+	log.Printf("[DEBUG] Updating Service Catalog Launch Constraint: %s", input)
+	if _, err := conn.UpdateConstraint(input); err != nil {
+		return fmt.Errorf("error updating Service Catalog Launch Constraint (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsServiceCatalogLaunchConstraintRead(d, meta)
+}
+
+func resourceAwsServiceCatalogConstraintDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	input := &servicecatalog.DeleteConstraintInput{
+		AcceptLanguage: aws.String("en"),
+		Id:             aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting Service Catalog Constraint: %s", input)
+	_, err := conn.DeleteConstraint(input)
+
+	if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Service Catalog Constraint (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// The constraint APIs do not return the portfolio or product a constraint
+// belongs to, so both have to be supplied alongside the constraint ID on import.
+func resourceAwsServiceCatalogConstraintImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), ":")
+
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected PORTFOLIO-ID:PRODUCT-ID:CONSTRAINT-ID", d.Id())
+	}
+
+	d.Set("portfolio_id", parts[0])
+	d.Set("product_id", parts[1])
+	d.SetId(parts[2])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func serviceCatalogDescribeConstraint(conn *servicecatalog.ServiceCatalog, id string) (*servicecatalog.DescribeConstraintOutput, error) {
+	output, err := conn.DescribeConstraint(&servicecatalog.DescribeConstraintInput{
+		AcceptLanguage: aws.String("en"),
+		Id:             aws.String(id),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ConstraintDetail == nil {
+		return nil, fmt.Errorf("empty response")
+	}
+
+	return output, nil
+}
+
+func expandServiceCatalogLaunchConstraintParameters(d *schema.ResourceData) (string, error) {
+	parameters := serviceCatalogLaunchConstraintParameters{
+		LocalRoleName: d.Get("local_role_name").(string),
+		RoleArn:       d.Get("role_arn").(string),
+	}
+
+	if parameters.LocalRoleName == "" && parameters.RoleArn == "" {
+		return "", fmt.Errorf("one of local_role_name or role_arn must be configured")
+	}
+
+	b, err := json.Marshal(parameters)
+	if err != nil {
+		return "", fmt.Errorf("error building Service Catalog Launch Constraint parameters: %s", err)
+	}
+
+	return string(b), nil
+}
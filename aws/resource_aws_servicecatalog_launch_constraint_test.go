@@ -0,0 +1,135 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSServiceCatalogLaunchConstraint_basic(t *testing.T) {
+	resourceName := "aws_servicecatalog_launch_constraint.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSServiceCatalogConstraintDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogLaunchConstraintConfig(rName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogConstraintExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttrSet(resourceName, "owner"),
+					resource.TestCheckResourceAttrPair(resourceName, "portfolio_id", "aws_servicecatalog_portfolio.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "product_id", "aws_servicecatalog_product.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSServiceCatalogConstraintImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSServiceCatalogLaunchConstraintConfig(rName, "description2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogConstraintExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSServiceCatalogConstraintExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Service Catalog Constraint ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).scconn
+
+		_, err := serviceCatalogDescribeConstraint(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckAWSServiceCatalogConstraintDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).scconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicecatalog_launch_constraint" && rs.Type != "aws_servicecatalog_template_constraint" {
+			continue
+		}
+
+		_, err := conn.DescribeConstraint(&servicecatalog.DescribeConstraintInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Service Catalog Constraint (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSServiceCatalogConstraintImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s:%s:%s", rs.Primary.Attributes["portfolio_id"], rs.Primary.Attributes["product_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccAWSServiceCatalogLaunchConstraintConfig(rName, description string) string {
+	return testAccAWSServiceCatalogProductPortfolioAssociationConfig(rName) + fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "servicecatalog.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_servicecatalog_launch_constraint" "test" {
+  description  = %[2]q
+  portfolio_id = "${aws_servicecatalog_product_portfolio_association.test.portfolio_id}"
+  product_id   = "${aws_servicecatalog_product_portfolio_association.test.product_id}"
+  role_arn     = "${aws_iam_role.test.arn}"
+}
+`, rName, description)
+}
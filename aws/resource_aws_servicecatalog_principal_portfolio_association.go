@@ -0,0 +1,154 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsServiceCatalogPrincipalPortfolioAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogPrincipalPortfolioAssociationCreate,
+		Read:   resourceAwsServiceCatalogPrincipalPortfolioAssociationRead,
+		Delete: resourceAwsServiceCatalogPrincipalPortfolioAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"portfolio_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"principal_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"principal_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  servicecatalog.PrincipalTypeIam,
+				ValidateFunc: validation.StringInSlice([]string{
+					servicecatalog.PrincipalTypeIam,
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceAwsServiceCatalogPrincipalPortfolioAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+	portfolioID := d.Get("portfolio_id").(string)
+	principalARN := d.Get("principal_arn").(string)
+
+	input := &servicecatalog.AssociatePrincipalWithPortfolioInput{
+		AcceptLanguage: aws.String("en"),
+		PortfolioId:    aws.String(portfolioID),
+		PrincipalARN:   aws.String(principalARN),
+		PrincipalType:  aws.String(d.Get("principal_type").(string)),
+	}
+
+	log.Printf("[DEBUG] Associating Service Catalog Principal with Portfolio: %s", input)
+	if _, err := conn.AssociatePrincipalWithPortfolio(input); err != nil {
+		return fmt.Errorf("error associating Service Catalog Principal (%s) with Portfolio (%s): %s", principalARN, portfolioID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s,%s", portfolioID, principalARN))
+
+	return resourceAwsServiceCatalogPrincipalPortfolioAssociationRead(d, meta)
+}
+
+func resourceAwsServiceCatalogPrincipalPortfolioAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	portfolioID, principalARN, err := resourceAwsServiceCatalogPrincipalPortfolioAssociationParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &servicecatalog.ListPrincipalsForPortfolioInput{
+		AcceptLanguage: aws.String("en"),
+		PortfolioId:    aws.String(portfolioID),
+	}
+
+	var principal *servicecatalog.Principal
+	err = conn.ListPrincipalsForPortfolioPages(input, func(page *servicecatalog.ListPrincipalsForPortfolioOutput, lastPage bool) bool {
+		for _, p := range page.Principals {
+			if aws.StringValue(p.PrincipalARN) == principalARN {
+				principal = p
+				return false
+			}
+		}
+		return !lastPage
+	})
+
+	if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Service Catalog Principal Portfolio Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Service Catalog Principal Portfolio Association (%s): %s", d.Id(), err)
+	}
+
+	if principal == nil {
+		log.Printf("[WARN] Service Catalog Principal Portfolio Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("portfolio_id", portfolioID)
+	d.Set("principal_arn", principal.PrincipalARN)
+	d.Set("principal_type", principal.PrincipalType)
+
+	return nil
+}
+
+func resourceAwsServiceCatalogPrincipalPortfolioAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	portfolioID, principalARN, err := resourceAwsServiceCatalogPrincipalPortfolioAssociationParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &servicecatalog.DisassociatePrincipalFromPortfolioInput{
+		AcceptLanguage: aws.String("en"),
+		PortfolioId:    aws.String(portfolioID),
+		PrincipalARN:   aws.String(principalARN),
+	}
+
+	log.Printf("[DEBUG] Disassociating Service Catalog Principal from Portfolio: %s", input)
+	_, err = conn.DisassociatePrincipalFromPortfolio(input)
+
+	if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error disassociating Service Catalog Principal (%s) from Portfolio (%s): %s", principalARN, portfolioID, err)
+	}
+
+	return nil
+}
+
+func resourceAwsServiceCatalogPrincipalPortfolioAssociationParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ",", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected PORTFOLIO-ID,PRINCIPAL-ARN", id)
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,140 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSServiceCatalogPrincipalPortfolioAssociation_basic(t *testing.T) {
+	resourceName := "aws_servicecatalog_principal_portfolio_association.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSServiceCatalogPrincipalPortfolioAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogPrincipalPortfolioAssociationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogPrincipalPortfolioAssociationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "portfolio_id", "aws_servicecatalog_portfolio.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "principal_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "principal_type", servicecatalog.PrincipalTypeIam),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSServiceCatalogPrincipalPortfolioAssociationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		found, err := testAccAWSServiceCatalogPrincipalPortfolioAssociationFind(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			return fmt.Errorf("Service Catalog Principal Portfolio Association (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSServiceCatalogPrincipalPortfolioAssociationDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicecatalog_principal_portfolio_association" {
+			continue
+		}
+
+		found, err := testAccAWSServiceCatalogPrincipalPortfolioAssociationFind(rs.Primary.ID)
+
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if found {
+			return fmt.Errorf("Service Catalog Principal Portfolio Association (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSServiceCatalogPrincipalPortfolioAssociationFind(id string) (bool, error) {
+	conn := testAccProvider.Meta().(*AWSClient).scconn
+
+	portfolioID, principalARN, err := resourceAwsServiceCatalogPrincipalPortfolioAssociationParseID(id)
+	if err != nil {
+		return false, err
+	}
+
+	found := false
+	err = conn.ListPrincipalsForPortfolioPages(&servicecatalog.ListPrincipalsForPortfolioInput{
+		PortfolioId: aws.String(portfolioID),
+	}, func(page *servicecatalog.ListPrincipalsForPortfolioOutput, lastPage bool) bool {
+		for _, principal := range page.Principals {
+			if aws.StringValue(principal.PrincipalARN) == principalARN {
+				found = true
+				return false
+			}
+		}
+		return !lastPage
+	})
+
+	return found, err
+}
+
+func testAccAWSServiceCatalogPrincipalPortfolioAssociationConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "servicecatalog.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_servicecatalog_portfolio" "test" {
+  name          = %[2]q
+  provider_name = "test-provider"
+}
+
+resource "aws_servicecatalog_principal_portfolio_association" "test" {
+  portfolio_id  = "${aws_servicecatalog_portfolio.test.id}"
+  principal_arn = "${aws_iam_role.test.arn}"
+}
+`, rName, rName[len(rName)-20:])
+}
@@ -0,0 +1,429 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsServiceCatalogProduct() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogProductCreate,
+		Read:   resourceAwsServiceCatalogProductRead,
+		Update: resourceAwsServiceCatalogProductUpdate,
+		Delete: resourceAwsServiceCatalogProductDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"distributor": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"has_default_path": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"owner": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"provisioning_artifact_parameters": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"disable_template_validation": {
+							Type:             schema.TypeBool,
+							Optional:         true,
+							ForceNew:         true,
+							DiffSuppressFunc: suppressServiceCatalogProvisioningArtifactCreateOnlyDiff,
+						},
+						"load_template_from_url": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  servicecatalog.ProvisioningArtifactTypeCloudFormationTemplate,
+							ValidateFunc: validation.StringInSlice([]string{
+								servicecatalog.ProvisioningArtifactTypeCloudFormationTemplate,
+								servicecatalog.ProvisioningArtifactTypeMarketplaceAmi,
+								servicecatalog.ProvisioningArtifactTypeMarketplaceCar,
+							}, false),
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"support_description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"support_email": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"support_url": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": tagsSchema(),
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					servicecatalog.ProductTypeCloudFormationTemplate,
+					servicecatalog.ProductTypeMarketplace,
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceAwsServiceCatalogProductCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	input := &servicecatalog.CreateProductInput{
+		AcceptLanguage:                 aws.String("en"),
+		IdempotencyToken:               aws.String(resource.UniqueId()),
+		Name:                           aws.String(d.Get("name").(string)),
+		Owner:                          aws.String(d.Get("owner").(string)),
+		ProductType:                    aws.String(d.Get("type").(string)),
+		ProvisioningArtifactParameters: expandServiceCatalogProvisioningArtifactProperties(d.Get("provisioning_artifact_parameters").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("distributor"); ok {
+		input.Distributor = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("support_description"); ok {
+		input.SupportDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("support_email"); ok {
+		input.SupportEmail = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("support_url"); ok {
+		input.SupportUrl = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		input.Tags = expandServiceCatalogTags(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating Service Catalog Product: %s", input)
+	output, err := conn.CreateProduct(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Service Catalog Product: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.ProductViewDetail.ProductViewSummary.ProductId))
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{servicecatalog.StatusCreating},
+		Target:  []string{servicecatalog.StatusAvailable},
+		Refresh: serviceCatalogProductStatusRefreshFunc(conn, d.Id()),
+		Timeout: d.Timeout(schema.TimeoutCreate),
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for Service Catalog Product (%s) to become available: %s", d.Id(), err)
+	}
+
+	return resourceAwsServiceCatalogProductRead(d, meta)
+}
+
+func resourceAwsServiceCatalogProductRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	input := &servicecatalog.DescribeProductAsAdminInput{
+		AcceptLanguage: aws.String("en"),
+		Id:             aws.String(d.Id()),
+	}
+
+	output, err := conn.DescribeProductAsAdmin(input)
+
+	if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Service Catalog Product (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Service Catalog Product (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.ProductViewDetail == nil || output.ProductViewDetail.ProductViewSummary == nil {
+		return fmt.Errorf("error reading Service Catalog Product (%s): empty response", d.Id())
+	}
+
+	detail := output.ProductViewDetail
+	summary := detail.ProductViewSummary
+
+	d.Set("arn", detail.ProductARN)
+	if detail.CreatedTime != nil {
+		d.Set("created_time", detail.CreatedTime.Format(time.RFC3339))
+	}
+	d.Set("description", summary.ShortDescription)
+	d.Set("distributor", summary.Distributor)
+	d.Set("has_default_path", summary.HasDefaultPath)
+	d.Set("name", summary.Name)
+	d.Set("owner", summary.Owner)
+	d.Set("status", detail.Status)
+	d.Set("support_description", summary.SupportDescription)
+	d.Set("support_email", summary.SupportEmail)
+	d.Set("support_url", summary.SupportUrl)
+	d.Set("type", summary.Type)
+
+	if err := d.Set("tags", flattenServiceCatalogTags(output.Tags)); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	// The initial provisioning artifact is only known to the API as the product's oldest
+	// artifact, so it is only read back when missing from state, e.g. after import.
+	if len(d.Get("provisioning_artifact_parameters").([]interface{})) == 0 {
+		parameters, err := flattenServiceCatalogProductInitialProvisioningArtifact(conn, d.Id(), output.ProvisioningArtifactSummaries)
+		if err != nil {
+			return fmt.Errorf("error reading Service Catalog Product (%s) provisioning artifact: %s", d.Id(), err)
+		}
+
+		if err := d.Set("provisioning_artifact_parameters", parameters); err != nil {
+			return fmt.Errorf("error setting provisioning_artifact_parameters: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceAwsServiceCatalogProductUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	input := &servicecatalog.UpdateProductInput{
+		AcceptLanguage:     aws.String("en"),
+		Id:                 aws.String(d.Id()),
+		Description:        aws.String(d.Get("description").(string)),
+		Distributor:        aws.String(d.Get("distributor").(string)),
+		Name:               aws.String(d.Get("name").(string)),
+		Owner:              aws.String(d.Get("owner").(string)),
+		SupportDescription: aws.String(d.Get("support_description").(string)),
+		SupportEmail:       aws.String(d.Get("support_email").(string)),
+		SupportUrl:         aws.String(d.Get("support_url").(string)),
+	}
+
+	if d.HasChange("tags") {
+		o, n := d.GetChange("tags")
+		input.AddTags, input.RemoveTags = tagUpdates(n.(map[string]interface{}), o.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Updating Service Catalog Product: %s", input)
+	if _, err := conn.UpdateProduct(input); err != nil {
+		return fmt.Errorf("error updating Service Catalog Product (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsServiceCatalogProductRead(d, meta)
+}
+
+func resourceAwsServiceCatalogProductDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	input := &servicecatalog.DeleteProductInput{
+		AcceptLanguage: aws.String("en"),
+		Id:             aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting Service Catalog Product: %s", input)
+	_, err := conn.DeleteProduct(input)
+
+	if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Service Catalog Product (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func serviceCatalogProductStatusRefreshFunc(conn *servicecatalog.ServiceCatalog, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.DescribeProductAsAdmin(&servicecatalog.DescribeProductAsAdminInput{
+			AcceptLanguage: aws.String("en"),
+			Id:             aws.String(id),
+		})
+
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil || output.ProductViewDetail == nil {
+			return nil, "", nil
+		}
+
+		status := aws.StringValue(output.ProductViewDetail.Status)
+
+		if status == servicecatalog.StatusFailed {
+			return output, status, fmt.Errorf("Service Catalog Product (%s) creation failed", id)
+		}
+
+		return output, status, nil
+	}
+}
+
+func expandServiceCatalogProvisioningArtifactProperties(l []interface{}) *servicecatalog.ProvisioningArtifactProperties {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	properties := &servicecatalog.ProvisioningArtifactProperties{
+		DisableTemplateValidation: aws.Bool(m["disable_template_validation"].(bool)),
+		Info: map[string]*string{
+			"LoadTemplateFromURL": aws.String(m["load_template_from_url"].(string)),
+		},
+	}
+
+	if v, ok := m["description"].(string); ok && v != "" {
+		properties.Description = aws.String(v)
+	}
+
+	if v, ok := m["name"].(string); ok && v != "" {
+		properties.Name = aws.String(v)
+	}
+
+	if v, ok := m["type"].(string); ok && v != "" {
+		properties.Type = aws.String(v)
+	}
+
+	return properties
+}
+
+func flattenServiceCatalogProductInitialProvisioningArtifact(conn *servicecatalog.ServiceCatalog, productID string, summaries []*servicecatalog.ProvisioningArtifactSummary) ([]interface{}, error) {
+	var initial *servicecatalog.ProvisioningArtifactSummary
+
+	for _, summary := range summaries {
+		if summary == nil || summary.CreatedTime == nil {
+			continue
+		}
+
+		if initial == nil || summary.CreatedTime.Before(aws.TimeValue(initial.CreatedTime)) {
+			initial = summary
+		}
+	}
+
+	if initial == nil {
+		return []interface{}{}, nil
+	}
+
+	output, err := conn.DescribeProvisioningArtifact(&servicecatalog.DescribeProvisioningArtifactInput{
+		AcceptLanguage:         aws.String("en"),
+		ProductId:              aws.String(productID),
+		ProvisioningArtifactId: initial.Id,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ProvisioningArtifactDetail == nil {
+		return []interface{}{}, nil
+	}
+
+	m := map[string]interface{}{
+		"description":                 aws.StringValue(output.ProvisioningArtifactDetail.Description),
+		"disable_template_validation": false,
+		"load_template_from_url":      aws.StringValue(output.Info["TemplateUrl"]),
+		"name":                        aws.StringValue(output.ProvisioningArtifactDetail.Name),
+		"type":                        aws.StringValue(output.ProvisioningArtifactDetail.Type),
+	}
+
+	return []interface{}{m}, nil
+}
+
+// suppressServiceCatalogProvisioningArtifactCreateOnlyDiff ignores changes to arguments
+// that only apply when a provisioning artifact is created and cannot be read back.
+func suppressServiceCatalogProvisioningArtifactCreateOnlyDiff(k, old, new string, d *schema.ResourceData) bool {
+	return d.Id() != ""
+}
+
+func expandServiceCatalogTags(m map[string]interface{}) []*servicecatalog.Tag {
+	tags := make([]*servicecatalog.Tag, 0, len(m))
+
+	for k, v := range m {
+		tags = append(tags, &servicecatalog.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v.(string)),
+		})
+	}
+
+	return tags
+}
+
+func flattenServiceCatalogTags(tags []*servicecatalog.Tag) map[string]string {
+	m := make(map[string]string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+
+	return m
+}
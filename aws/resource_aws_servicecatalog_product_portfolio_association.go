@@ -0,0 +1,150 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsServiceCatalogProductPortfolioAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogProductPortfolioAssociationCreate,
+		Read:   resourceAwsServiceCatalogProductPortfolioAssociationRead,
+		Delete: resourceAwsServiceCatalogProductPortfolioAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"portfolio_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"product_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source_portfolio_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsServiceCatalogProductPortfolioAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+	portfolioID := d.Get("portfolio_id").(string)
+	productID := d.Get("product_id").(string)
+
+	input := &servicecatalog.AssociateProductWithPortfolioInput{
+		AcceptLanguage: aws.String("en"),
+		PortfolioId:    aws.String(portfolioID),
+		ProductId:      aws.String(productID),
+	}
+
+	if v, ok := d.GetOk("source_portfolio_id"); ok {
+		input.SourcePortfolioId = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Associating Service Catalog Product with Portfolio: %s", input)
+	if _, err := conn.AssociateProductWithPortfolio(input); err != nil {
+		return fmt.Errorf("error associating Service Catalog Product (%s) with Portfolio (%s): %s", productID, portfolioID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", portfolioID, productID))
+
+	return resourceAwsServiceCatalogProductPortfolioAssociationRead(d, meta)
+}
+
+func resourceAwsServiceCatalogProductPortfolioAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	portfolioID, productID, err := resourceAwsServiceCatalogProductPortfolioAssociationParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &servicecatalog.ListPortfoliosForProductInput{
+		AcceptLanguage: aws.String("en"),
+		ProductId:      aws.String(productID),
+	}
+
+	found := false
+	err = conn.ListPortfoliosForProductPages(input, func(page *servicecatalog.ListPortfoliosForProductOutput, lastPage bool) bool {
+		for _, portfolio := range page.PortfolioDetails {
+			if aws.StringValue(portfolio.Id) == portfolioID {
+				found = true
+				return false
+			}
+		}
+		return !lastPage
+	})
+
+	if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Service Catalog Product Portfolio Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Service Catalog Product Portfolio Association (%s): %s", d.Id(), err)
+	}
+
+	if !found {
+		log.Printf("[WARN] Service Catalog Product Portfolio Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("portfolio_id", portfolioID)
+	d.Set("product_id", productID)
+
+	return nil
+}
+
+func resourceAwsServiceCatalogProductPortfolioAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	portfolioID, productID, err := resourceAwsServiceCatalogProductPortfolioAssociationParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &servicecatalog.DisassociateProductFromPortfolioInput{
+		AcceptLanguage: aws.String("en"),
+		PortfolioId:    aws.String(portfolioID),
+		ProductId:      aws.String(productID),
+	}
+
+	log.Printf("[DEBUG] Disassociating Service Catalog Product from Portfolio: %s", input)
+	_, err = conn.DisassociateProductFromPortfolio(input)
+
+	if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error disassociating Service Catalog Product (%s) from Portfolio (%s): %s", productID, portfolioID, err)
+	}
+
+	return nil
+}
+
+func resourceAwsServiceCatalogProductPortfolioAssociationParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected PORTFOLIO-ID:PRODUCT-ID", id)
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,124 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSServiceCatalogProductPortfolioAssociation_basic(t *testing.T) {
+	resourceName := "aws_servicecatalog_product_portfolio_association.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSServiceCatalogProductPortfolioAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogProductPortfolioAssociationConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogProductPortfolioAssociationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "portfolio_id", "aws_servicecatalog_portfolio.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "product_id", "aws_servicecatalog_product.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSServiceCatalogProductPortfolioAssociationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		found, err := testAccAWSServiceCatalogProductPortfolioAssociationFind(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			return fmt.Errorf("Service Catalog Product Portfolio Association (%s) not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSServiceCatalogProductPortfolioAssociationDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicecatalog_product_portfolio_association" {
+			continue
+		}
+
+		found, err := testAccAWSServiceCatalogProductPortfolioAssociationFind(rs.Primary.ID)
+
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if found {
+			return fmt.Errorf("Service Catalog Product Portfolio Association (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSServiceCatalogProductPortfolioAssociationFind(id string) (bool, error) {
+	conn := testAccProvider.Meta().(*AWSClient).scconn
+
+	portfolioID, productID, err := resourceAwsServiceCatalogProductPortfolioAssociationParseID(id)
+	if err != nil {
+		return false, err
+	}
+
+	found := false
+	err = conn.ListPortfoliosForProductPages(&servicecatalog.ListPortfoliosForProductInput{
+		ProductId: aws.String(productID),
+	}, func(page *servicecatalog.ListPortfoliosForProductOutput, lastPage bool) bool {
+		for _, portfolio := range page.PortfolioDetails {
+			if aws.StringValue(portfolio.Id) == portfolioID {
+				found = true
+				return false
+			}
+		}
+		return !lastPage
+	})
+
+	return found, err
+}
+
+func testAccAWSServiceCatalogProductPortfolioAssociationConfigBase(rName string) string {
+	return testAccAWSServiceCatalogProductConfig(rName, "description") + fmt.Sprintf(`
+resource "aws_servicecatalog_portfolio" "test" {
+  name          = %[1]q
+  provider_name = "test-provider"
+}
+`, rName[len(rName)-20:])
+}
+
+func testAccAWSServiceCatalogProductPortfolioAssociationConfig(rName string) string {
+	return testAccAWSServiceCatalogProductPortfolioAssociationConfigBase(rName) + `
+resource "aws_servicecatalog_product_portfolio_association" "test" {
+  portfolio_id = "${aws_servicecatalog_portfolio.test.id}"
+  product_id   = "${aws_servicecatalog_product.test.id}"
+}
+`
+}
@@ -0,0 +1,225 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSServiceCatalogProduct_basic(t *testing.T) {
+	var product servicecatalog.DescribeProductAsAdminOutput
+	resourceName := "aws_servicecatalog_product.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSServiceCatalogProductDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogProductConfig(rName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogProductExists(resourceName, &product),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "catalog", regexp.MustCompile(`product/prod-.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "created_time"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "owner", "test-owner"),
+					resource.TestCheckResourceAttr(resourceName, "status", servicecatalog.StatusAvailable),
+					resource.TestCheckResourceAttr(resourceName, "support_email", "test@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "type", servicecatalog.ProductTypeCloudFormationTemplate),
+					resource.TestCheckResourceAttr(resourceName, "provisioning_artifact_parameters.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSServiceCatalogProductConfig(rName, "description2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogProductExists(resourceName, &product),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSServiceCatalogProduct_Tags(t *testing.T) {
+	var product servicecatalog.DescribeProductAsAdminOutput
+	resourceName := "aws_servicecatalog_product.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSServiceCatalogProductDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogProductConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogProductExists(resourceName, &product),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSServiceCatalogProductConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogProductExists(resourceName, &product),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAWSServiceCatalogProductConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogProductExists(resourceName, &product),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSServiceCatalogProductExists(resourceName string, product *servicecatalog.DescribeProductAsAdminOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Service Catalog Product ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).scconn
+
+		output, err := conn.DescribeProductAsAdmin(&servicecatalog.DescribeProductAsAdminInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		*product = *output
+
+		return nil
+	}
+}
+
+func testAccCheckAWSServiceCatalogProductDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).scconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicecatalog_product" {
+			continue
+		}
+
+		_, err := conn.DescribeProductAsAdmin(&servicecatalog.DescribeProductAsAdminInput{
+			Id: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Service Catalog Product (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+// testAccAWSServiceCatalogProductConfigBase uploads a minimal CloudFormation
+// template that Service Catalog products and provisioning artifacts can load.
+func testAccAWSServiceCatalogProductConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_object" "test" {
+  bucket = "${aws_s3_bucket.test.id}"
+  key    = "test.json"
+
+  content = <<EOF
+{
+  "Parameters": {
+    "InstanceType": {
+      "Type": "String",
+      "Default": "t2.micro"
+    }
+  },
+  "Resources": {
+    "Topic": {
+      "Type": "AWS::SNS::Topic"
+    }
+  }
+}
+EOF
+}
+`, rName)
+}
+
+func testAccAWSServiceCatalogProductConfigResource(rName, description, tags string) string {
+	return testAccAWSServiceCatalogProductConfigBase(rName) + fmt.Sprintf(`
+resource "aws_servicecatalog_product" "test" {
+  description   = %[2]q
+  name          = %[1]q
+  owner         = "test-owner"
+  support_email = "test@example.com"
+  type          = "CLOUD_FORMATION_TEMPLATE"
+
+  provisioning_artifact_parameters {
+    description            = "initial version"
+    load_template_from_url = "https://${aws_s3_bucket.test.bucket_regional_domain_name}/${aws_s3_bucket_object.test.key}"
+    name                   = "v1"
+  }
+
+  %[3]s
+}
+`, rName, description, tags)
+}
+
+func testAccAWSServiceCatalogProductConfig(rName, description string) string {
+	return testAccAWSServiceCatalogProductConfigResource(rName, description, "")
+}
+
+func testAccAWSServiceCatalogProductConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return testAccAWSServiceCatalogProductConfigResource(rName, "description", fmt.Sprintf(`
+  tags = {
+    %[1]q = %[2]q
+  }
+`, tagKey1, tagValue1))
+}
+
+func testAccAWSServiceCatalogProductConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return testAccAWSServiceCatalogProductConfigResource(rName, "description", fmt.Sprintf(`
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,238 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsServiceCatalogProvisioningArtifact() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogProvisioningArtifactCreate,
+		Read:   resourceAwsServiceCatalogProvisioningArtifactRead,
+		Update: resourceAwsServiceCatalogProvisioningArtifactUpdate,
+		Delete: resourceAwsServiceCatalogProvisioningArtifactDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"active": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"disable_template_validation": {
+				Type:             schema.TypeBool,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressServiceCatalogProvisioningArtifactCreateOnlyDiff,
+			},
+			"guidance": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  servicecatalog.ProvisioningArtifactGuidanceDefault,
+				ValidateFunc: validation.StringInSlice([]string{
+					servicecatalog.ProvisioningArtifactGuidanceDefault,
+					servicecatalog.ProvisioningArtifactGuidanceDeprecated,
+				}, false),
+			},
+			"load_template_from_url": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"product_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"provisioning_artifact_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  servicecatalog.ProvisioningArtifactTypeCloudFormationTemplate,
+				ValidateFunc: validation.StringInSlice([]string{
+					servicecatalog.ProvisioningArtifactTypeCloudFormationTemplate,
+					servicecatalog.ProvisioningArtifactTypeMarketplaceAmi,
+					servicecatalog.ProvisioningArtifactTypeMarketplaceCar,
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceAwsServiceCatalogProvisioningArtifactCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+	productID := d.Get("product_id").(string)
+
+	input := &servicecatalog.CreateProvisioningArtifactInput{
+		AcceptLanguage:   aws.String("en"),
+		IdempotencyToken: aws.String(resource.UniqueId()),
+		ProductId:        aws.String(productID),
+		Parameters: expandServiceCatalogProvisioningArtifactProperties([]interface{}{
+			map[string]interface{}{
+				"description":                 d.Get("description"),
+				"disable_template_validation": d.Get("disable_template_validation"),
+				"load_template_from_url":      d.Get("load_template_from_url"),
+				"name":                        d.Get("name"),
+				"type":                        d.Get("type"),
+			},
+		}),
+	}
+
+	log.Printf("[DEBUG] Creating Service Catalog Provisioning Artifact: %s", input)
+	output, err := conn.CreateProvisioningArtifact(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Service Catalog Provisioning Artifact for Product (%s): %s", productID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", productID, aws.StringValue(output.ProvisioningArtifactDetail.Id)))
+
+	// Guidance can only be set after creation.
+	if d.Get("guidance").(string) != servicecatalog.ProvisioningArtifactGuidanceDefault || !d.Get("active").(bool) {
+		return resourceAwsServiceCatalogProvisioningArtifactUpdate(d, meta)
+	}
+
+	return resourceAwsServiceCatalogProvisioningArtifactRead(d, meta)
+}
+
+func resourceAwsServiceCatalogProvisioningArtifactRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	productID, artifactID, err := resourceAwsServiceCatalogProvisioningArtifactParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &servicecatalog.DescribeProvisioningArtifactInput{
+		AcceptLanguage:         aws.String("en"),
+		ProductId:              aws.String(productID),
+		ProvisioningArtifactId: aws.String(artifactID),
+		Verbose:                aws.Bool(true),
+	}
+
+	output, err := conn.DescribeProvisioningArtifact(input)
+
+	if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Service Catalog Provisioning Artifact (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Service Catalog Provisioning Artifact (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.ProvisioningArtifactDetail == nil {
+		return fmt.Errorf("error reading Service Catalog Provisioning Artifact (%s): empty response", d.Id())
+	}
+
+	detail := output.ProvisioningArtifactDetail
+
+	d.Set("active", detail.Active)
+	if detail.CreatedTime != nil {
+		d.Set("created_time", detail.CreatedTime.Format(time.RFC3339))
+	}
+	d.Set("description", detail.Description)
+	d.Set("guidance", detail.Guidance)
+	d.Set("name", detail.Name)
+	d.Set("product_id", productID)
+	d.Set("provisioning_artifact_id", detail.Id)
+	d.Set("type", detail.Type)
+
+	if v, ok := output.Info["TemplateUrl"]; ok {
+		d.Set("load_template_from_url", v)
+	}
+
+	return nil
+}
+
+func resourceAwsServiceCatalogProvisioningArtifactUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	productID, artifactID, err := resourceAwsServiceCatalogProvisioningArtifactParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &servicecatalog.UpdateProvisioningArtifactInput{
+		AcceptLanguage:         aws.String("en"),
+		Active:                 aws.Bool(d.Get("active").(bool)),
+		Description:            aws.String(d.Get("description").(string)),
+		Guidance:               aws.String(d.Get("guidance").(string)),
+		Name:                   aws.String(d.Get("name").(string)),
+		ProductId:              aws.String(productID),
+		ProvisioningArtifactId: aws.String(artifactID),
+	}
+
+	log.Printf("[DEBUG] Updating Service Catalog Provisioning Artifact: %s", input)
+	if _, err := conn.UpdateProvisioningArtifact(input); err != nil {
+		return fmt.Errorf("error updating Service Catalog Provisioning Artifact (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsServiceCatalogProvisioningArtifactRead(d, meta)
+}
+
+func resourceAwsServiceCatalogProvisioningArtifactDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	productID, artifactID, err := resourceAwsServiceCatalogProvisioningArtifactParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &servicecatalog.DeleteProvisioningArtifactInput{
+		AcceptLanguage:         aws.String("en"),
+		ProductId:              aws.String(productID),
+		ProvisioningArtifactId: aws.String(artifactID),
+	}
+
+	log.Printf("[DEBUG] Deleting Service Catalog Provisioning Artifact: %s", input)
+	_, err = conn.DeleteProvisioningArtifact(input)
+
+	if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Service Catalog Provisioning Artifact (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsServiceCatalogProvisioningArtifactParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected PRODUCT-ID:PROVISIONING-ARTIFACT-ID", id)
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,118 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSServiceCatalogProvisioningArtifact_basic(t *testing.T) {
+	resourceName := "aws_servicecatalog_provisioning_artifact.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSServiceCatalogProvisioningArtifactDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogProvisioningArtifactConfig(rName, "DEFAULT"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogProvisioningArtifactExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "active", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_time"),
+					resource.TestCheckResourceAttr(resourceName, "description", "second version"),
+					resource.TestCheckResourceAttr(resourceName, "guidance", servicecatalog.ProvisioningArtifactGuidanceDefault),
+					resource.TestCheckResourceAttr(resourceName, "name", "v2"),
+					resource.TestCheckResourceAttrPair(resourceName, "product_id", "aws_servicecatalog_product.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "provisioning_artifact_id"),
+					resource.TestCheckResourceAttr(resourceName, "type", servicecatalog.ProvisioningArtifactTypeCloudFormationTemplate),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSServiceCatalogProvisioningArtifactConfig(rName, "DEPRECATED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogProvisioningArtifactExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "guidance", servicecatalog.ProvisioningArtifactGuidanceDeprecated),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSServiceCatalogProvisioningArtifactExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		productID, artifactID, err := resourceAwsServiceCatalogProvisioningArtifactParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).scconn
+
+		_, err = conn.DescribeProvisioningArtifact(&servicecatalog.DescribeProvisioningArtifactInput{
+			ProductId:              aws.String(productID),
+			ProvisioningArtifactId: aws.String(artifactID),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAWSServiceCatalogProvisioningArtifactDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).scconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicecatalog_provisioning_artifact" {
+			continue
+		}
+
+		productID, artifactID, err := resourceAwsServiceCatalogProvisioningArtifactParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.DescribeProvisioningArtifact(&servicecatalog.DescribeProvisioningArtifactInput{
+			ProductId:              aws.String(productID),
+			ProvisioningArtifactId: aws.String(artifactID),
+		})
+
+		if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Service Catalog Provisioning Artifact (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSServiceCatalogProvisioningArtifactConfig(rName, guidance string) string {
+	return testAccAWSServiceCatalogProductConfig(rName, "description") + fmt.Sprintf(`
+resource "aws_servicecatalog_provisioning_artifact" "test" {
+  description            = "second version"
+  guidance               = %[1]q
+  load_template_from_url = "https://${aws_s3_bucket.test.bucket_regional_domain_name}/${aws_s3_bucket_object.test.key}"
+  name                   = "v2"
+  product_id             = "${aws_servicecatalog_product.test.id}"
+}
+`, guidance)
+}
@@ -0,0 +1,158 @@
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+type serviceCatalogTemplateConstraintParameters struct {
+	Rules json.RawMessage
+}
+
+func resourceAwsServiceCatalogTemplateConstraint() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceCatalogTemplateConstraintCreate,
+		Read:   resourceAwsServiceCatalogTemplateConstraintRead,
+		Update: resourceAwsServiceCatalogTemplateConstraintUpdate,
+		Delete: resourceAwsServiceCatalogConstraintDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsServiceCatalogConstraintImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"owner": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"portfolio_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"product_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"rules": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.ValidateJsonString,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
+			},
+		},
+	}
+}
+
+func resourceAwsServiceCatalogTemplateConstraintCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	parameters, err := expandServiceCatalogTemplateConstraintParameters(d.Get("rules").(string))
+	if err != nil {
+		return err
+	}
+
+	input := &servicecatalog.CreateConstraintInput{
+		AcceptLanguage:   aws.String("en"),
+		IdempotencyToken: aws.String(resource.UniqueId()),
+		Parameters:       aws.String(parameters),
+		PortfolioId:      aws.String(d.Get("portfolio_id").(string)),
+		ProductId:        aws.String(d.Get("product_id").(string)),
+		Type:             aws.String(serviceCatalogConstraintTypeTemplate),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Service Catalog Template Constraint: %s", input)
+	output, err := conn.CreateConstraint(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Service Catalog Template Constraint: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.ConstraintDetail.ConstraintId))
+
+	return resourceAwsServiceCatalogTemplateConstraintRead(d, meta)
+}
+
+func resourceAwsServiceCatalogTemplateConstraintRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	output, err := serviceCatalogDescribeConstraint(conn, d.Id())
+
+	if isAWSErr(err, servicecatalog.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Service Catalog Template Constraint (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Service Catalog Template Constraint (%s): %s", d.Id(), err)
+	}
+
+	var parameters serviceCatalogTemplateConstraintParameters
+	if err := json.Unmarshal([]byte(aws.StringValue(output.ConstraintParameters)), &parameters); err != nil {
+		return fmt.Errorf("error parsing Service Catalog Template Constraint (%s) parameters: %s", d.Id(), err)
+	}
+
+	rules, err := structure.NormalizeJsonString(string(parameters.Rules))
+	if err != nil {
+		return fmt.Errorf("error normalizing Service Catalog Template Constraint (%s) rules: %s", d.Id(), err)
+	}
+
+	d.Set("description", output.ConstraintDetail.Description)
+	d.Set("owner", output.ConstraintDetail.Owner)
+	d.Set("rules", rules)
+
+	return nil
+}
+
+func resourceAwsServiceCatalogTemplateConstraintUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).scconn
+
+	parameters, err := expandServiceCatalogTemplateConstraintParameters(d.Get("rules").(string))
+	if err != nil {
+		return err
+	}
+
+	input := &servicecatalog.UpdateConstraintInput{
+		AcceptLanguage: aws.String("en"),
+		Description:    aws.String(d.Get("description").(string)),
+		Id:             aws.String(d.Id()),
+		Parameters:     aws.String(parameters),
+	}
+
+	log.Printf("[DEBUG] Updating Service Catalog Template Constraint: %s", input)
+	if _, err := conn.UpdateConstraint(input); err != nil {
+		return fmt.Errorf("error updating Service Catalog Template Constraint (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsServiceCatalogTemplateConstraintRead(d, meta)
+}
+
+func expandServiceCatalogTemplateConstraintParameters(rules string) (string, error) {
+	b, err := json.Marshal(serviceCatalogTemplateConstraintParameters{
+		Rules: json.RawMessage(rules),
+	})
+
+	if err != nil {
+		return "", fmt.Errorf("error building Service Catalog Template Constraint parameters: %s", err)
+	}
+
+	return string(b), nil
+}
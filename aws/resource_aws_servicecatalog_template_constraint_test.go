@@ -0,0 +1,71 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSServiceCatalogTemplateConstraint_basic(t *testing.T) {
+	resourceName := "aws_servicecatalog_template_constraint.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSServiceCatalogConstraintDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSServiceCatalogTemplateConstraintConfig(rName, "t2.micro"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogConstraintExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "instance types"),
+					resource.TestCheckResourceAttrSet(resourceName, "owner"),
+					resource.TestCheckResourceAttrPair(resourceName, "portfolio_id", "aws_servicecatalog_portfolio.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "product_id", "aws_servicecatalog_product.test", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "rules"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSServiceCatalogConstraintImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSServiceCatalogTemplateConstraintConfig(rName, "t3.micro"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSServiceCatalogConstraintExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "rules"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSServiceCatalogTemplateConstraintConfig(rName, instanceType string) string {
+	return testAccAWSServiceCatalogProductPortfolioAssociationConfig(rName) + fmt.Sprintf(`
+resource "aws_servicecatalog_template_constraint" "test" {
+  description  = "instance types"
+  portfolio_id = "${aws_servicecatalog_product_portfolio_association.test.portfolio_id}"
+  product_id   = "${aws_servicecatalog_product_portfolio_association.test.product_id}"
+
+  rules = <<EOF
+{
+  "InstanceTypeRule": {
+    "Assertions": [
+      {
+        "Assert": {
+          "Fn::Contains": [["%[1]s"], {"Ref": "InstanceType"}]
+        },
+        "AssertDescription": "Instance type must be %[1]s"
+      }
+    ]
+  }
+}
+EOF
+}
+`, instanceType)
+}
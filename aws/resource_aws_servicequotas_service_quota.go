@@ -0,0 +1,287 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsServiceQuotasServiceQuota() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceQuotasServiceQuotaCreate,
+		Read:   resourceAwsServiceQuotasServiceQuotaRead,
+		Update: resourceAwsServiceQuotasServiceQuotaUpdate,
+		Delete: schema.Noop,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceAwsServiceQuotasServiceQuotaCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"adjustable": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_value": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"global_quota": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"quota_code": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"quota_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"request_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"request_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_code": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"service_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"value": {
+				Type:     schema.TypeFloat,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceAwsServiceQuotasServiceQuotaCreate(d *schema.ResourceData, meta interface{}) error {
+	quotaCode := d.Get("quota_code").(string)
+	serviceCode := d.Get("service_code").(string)
+
+	d.SetId(fmt.Sprintf("%s/%s", serviceCode, quotaCode))
+
+	if err := resourceAwsServiceQuotasServiceQuotaRequestIncrease(d, meta); err != nil {
+		d.SetId("")
+		return err
+	}
+
+	return resourceAwsServiceQuotasServiceQuotaRead(d, meta)
+}
+
+func resourceAwsServiceQuotasServiceQuotaRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).servicequotasconn
+
+	serviceCode, quotaCode, err := resourceAwsServiceQuotasServiceQuotaParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	output, err := conn.GetServiceQuota(&servicequotas.GetServiceQuotaInput{
+		QuotaCode:   aws.String(quotaCode),
+		ServiceCode: aws.String(serviceCode),
+	})
+
+	if isAWSErr(err, servicequotas.ErrCodeNoSuchResourceException, "") {
+		log.Printf("[WARN] Service Quotas Service Quota (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error getting Service Quotas Service Quota (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.Quota == nil {
+		return fmt.Errorf("error getting Service Quotas Service Quota (%s): empty result", d.Id())
+	}
+
+	defaultOutput, err := conn.GetAWSDefaultServiceQuota(&servicequotas.GetAWSDefaultServiceQuotaInput{
+		QuotaCode:   aws.String(quotaCode),
+		ServiceCode: aws.String(serviceCode),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error getting Service Quotas Service Quota (%s) default value: %s", d.Id(), err)
+	}
+
+	if defaultOutput == nil || defaultOutput.Quota == nil {
+		return fmt.Errorf("error getting Service Quotas Service Quota (%s) default value: empty result", d.Id())
+	}
+
+	quota := output.Quota
+
+	d.Set("adjustable", quota.Adjustable)
+	d.Set("arn", quota.QuotaArn)
+	d.Set("default_value", defaultOutput.Quota.Value)
+	d.Set("global_quota", quota.GlobalQuota)
+	d.Set("quota_code", quota.QuotaCode)
+	d.Set("quota_name", quota.QuotaName)
+	d.Set("service_code", quota.ServiceCode)
+	d.Set("service_name", quota.ServiceName)
+	d.Set("value", quota.Value)
+
+	if requestID := d.Get("request_id").(string); requestID != "" {
+		requestOutput, err := conn.GetRequestedServiceQuotaChange(&servicequotas.GetRequestedServiceQuotaChangeInput{
+			RequestId: aws.String(requestID),
+		})
+
+		if isAWSErr(err, servicequotas.ErrCodeNoSuchResourceException, "") {
+			d.Set("request_id", "")
+			d.Set("request_status", "")
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error getting Service Quotas Service Quota (%s) increase request (%s): %s", d.Id(), requestID, err)
+		}
+
+		if requestOutput == nil || requestOutput.RequestedQuota == nil {
+			return fmt.Errorf("error getting Service Quotas Service Quota (%s) increase request (%s): empty result", d.Id(), requestID)
+		}
+
+		request := requestOutput.RequestedQuota
+		status := aws.StringValue(request.Status)
+
+		// While the increase is being processed, report the requested value
+		// so that the configuration does not show a perpetual difference.
+		switch status {
+		case servicequotas.RequestStatusPending, servicequotas.RequestStatusCaseOpened:
+			d.Set("value", request.DesiredValue)
+		}
+
+		d.Set("request_status", status)
+	}
+
+	return nil
+}
+
+func resourceAwsServiceQuotasServiceQuotaUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("value") {
+		if err := resourceAwsServiceQuotasServiceQuotaRequestIncrease(d, meta); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsServiceQuotasServiceQuotaRead(d, meta)
+}
+
+// resourceAwsServiceQuotasServiceQuotaCustomizeDiff rejects values below the
+// current quota during plan, as quotas cannot be decreased through the API.
+func resourceAwsServiceQuotasServiceQuotaCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChange("value") || !diff.NewValueKnown("value") {
+		return nil
+	}
+
+	if !diff.NewValueKnown("quota_code") || !diff.NewValueKnown("service_code") {
+		return nil
+	}
+
+	conn := meta.(*AWSClient).servicequotasconn
+	quotaCode := diff.Get("quota_code").(string)
+	serviceCode := diff.Get("service_code").(string)
+	value := diff.Get("value").(float64)
+
+	output, err := conn.GetServiceQuota(&servicequotas.GetServiceQuotaInput{
+		QuotaCode:   aws.String(quotaCode),
+		ServiceCode: aws.String(serviceCode),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error getting Service Quotas Service Quota (%s/%s): %s", serviceCode, quotaCode, err)
+	}
+
+	if output == nil || output.Quota == nil {
+		return fmt.Errorf("error getting Service Quotas Service Quota (%s/%s): empty result", serviceCode, quotaCode)
+	}
+
+	if currentValue := aws.Float64Value(output.Quota.Value); value < currentValue {
+		return fmt.Errorf("requesting Service Quotas Service Quota (%s/%s) with value less than current value (%g) is not supported", serviceCode, quotaCode, currentValue)
+	}
+
+	return nil
+}
+
+// resourceAwsServiceQuotasServiceQuotaRequestIncrease requests an increase
+// when the configured value is above the current quota. Quotas cannot be
+// decreased through the API.
+func resourceAwsServiceQuotasServiceQuotaRequestIncrease(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).servicequotasconn
+	quotaCode := d.Get("quota_code").(string)
+	serviceCode := d.Get("service_code").(string)
+	value := d.Get("value").(float64)
+
+	output, err := conn.GetServiceQuota(&servicequotas.GetServiceQuotaInput{
+		QuotaCode:   aws.String(quotaCode),
+		ServiceCode: aws.String(serviceCode),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error getting Service Quotas Service Quota (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.Quota == nil {
+		return fmt.Errorf("error getting Service Quotas Service Quota (%s): empty result", d.Id())
+	}
+
+	currentValue := aws.Float64Value(output.Quota.Value)
+
+	if value < currentValue {
+		return fmt.Errorf("requesting Service Quotas Service Quota (%s) with value less than current value (%g) is not supported", d.Id(), currentValue)
+	}
+
+	if value == currentValue {
+		return nil
+	}
+
+	input := &servicequotas.RequestServiceQuotaIncreaseInput{
+		DesiredValue: aws.Float64(value),
+		QuotaCode:    aws.String(quotaCode),
+		ServiceCode:  aws.String(serviceCode),
+	}
+
+	log.Printf("[DEBUG] Requesting Service Quotas Service Quota increase: %s", input)
+	requestOutput, err := conn.RequestServiceQuotaIncrease(input)
+
+	if err != nil {
+		return fmt.Errorf("error requesting Service Quotas Service Quota (%s) increase: %s", d.Id(), err)
+	}
+
+	if requestOutput == nil || requestOutput.RequestedQuota == nil {
+		return fmt.Errorf("error requesting Service Quotas Service Quota (%s) increase: empty result", d.Id())
+	}
+
+	d.Set("request_id", requestOutput.RequestedQuota.Id)
+	d.Set("request_status", requestOutput.RequestedQuota.Status)
+
+	return nil
+}
+
+func resourceAwsServiceQuotasServiceQuotaParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected SERVICE-CODE/QUOTA-CODE", id)
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,81 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAwsServiceQuotasServiceQuota_basic(t *testing.T) {
+	dataSourceName := "data.aws_servicequotas_service_quota.test"
+	resourceName := "aws_servicequotas_service_quota.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsServiceQuotasServiceQuotaConfigSameValue("vpc", "L-F678F1CE"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "adjustable", dataSourceName, "adjustable"),
+					resource.TestCheckResourceAttrPair(resourceName, "arn", dataSourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "default_value", dataSourceName, "default_value"),
+					resource.TestCheckResourceAttrPair(resourceName, "global_quota", dataSourceName, "global_quota"),
+					resource.TestCheckResourceAttrPair(resourceName, "quota_code", dataSourceName, "quota_code"),
+					resource.TestCheckResourceAttrPair(resourceName, "quota_name", dataSourceName, "quota_name"),
+					resource.TestCheckResourceAttr(resourceName, "request_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "request_status", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "service_code", dataSourceName, "service_code"),
+					resource.TestCheckResourceAttrPair(resourceName, "service_name", dataSourceName, "service_name"),
+					resource.TestCheckResourceAttrPair(resourceName, "value", dataSourceName, "value"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAwsServiceQuotasServiceQuota_Value_Decrease(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAwsServiceQuotasServiceQuotaConfigValue("vpc", "L-F678F1CE", 0),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`with value less than current value`),
+			},
+		},
+	})
+}
+
+func testAccAwsServiceQuotasServiceQuotaConfigSameValue(serviceCode, quotaCode string) string {
+	return fmt.Sprintf(`
+data "aws_servicequotas_service_quota" "test" {
+  quota_code   = %[2]q
+  service_code = %[1]q
+}
+
+resource "aws_servicequotas_service_quota" "test" {
+  quota_code   = "${data.aws_servicequotas_service_quota.test.quota_code}"
+  service_code = "${data.aws_servicequotas_service_quota.test.service_code}"
+  value        = "${data.aws_servicequotas_service_quota.test.value}"
+}
+`, serviceCode, quotaCode)
+}
+
+func testAccAwsServiceQuotasServiceQuotaConfigValue(serviceCode, quotaCode string, value int) string {
+	return fmt.Sprintf(`
+resource "aws_servicequotas_service_quota" "test" {
+  quota_code   = %[2]q
+  service_code = %[1]q
+  value        = %[3]d
+}
+`, serviceCode, quotaCode, value)
+}
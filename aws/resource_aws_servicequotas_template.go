@@ -0,0 +1,170 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsServiceQuotasTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsServiceQuotasTemplatePut,
+		Read:   resourceAwsServiceQuotasTemplateRead,
+		Update: resourceAwsServiceQuotasTemplatePut,
+		Delete: resourceAwsServiceQuotasTemplateDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"global_quota": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"quota_code": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"quota_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"service_code": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"service_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"unit": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"value": {
+				Type:     schema.TypeFloat,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceAwsServiceQuotasTemplatePut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).servicequotasconn
+
+	region := meta.(*AWSClient).region
+	if v, ok := d.GetOk("region"); ok {
+		region = v.(string)
+	}
+	quotaCode := d.Get("quota_code").(string)
+	serviceCode := d.Get("service_code").(string)
+
+	input := &servicequotas.PutServiceQuotaIncreaseRequestIntoTemplateInput{
+		AwsRegion:    aws.String(region),
+		DesiredValue: aws.Float64(d.Get("value").(float64)),
+		QuotaCode:    aws.String(quotaCode),
+		ServiceCode:  aws.String(serviceCode),
+	}
+
+	log.Printf("[DEBUG] Putting Service Quotas Template: %s", input)
+	if _, err := conn.PutServiceQuotaIncreaseRequestIntoTemplate(input); err != nil {
+		return fmt.Errorf("error putting Service Quotas Template (%s/%s/%s): %s", region, serviceCode, quotaCode, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", region, serviceCode, quotaCode))
+
+	return resourceAwsServiceQuotasTemplateRead(d, meta)
+}
+
+func resourceAwsServiceQuotasTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).servicequotasconn
+
+	region, serviceCode, quotaCode, err := resourceAwsServiceQuotasTemplateParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	output, err := conn.GetServiceQuotaIncreaseRequestFromTemplate(&servicequotas.GetServiceQuotaIncreaseRequestFromTemplateInput{
+		AwsRegion:   aws.String(region),
+		QuotaCode:   aws.String(quotaCode),
+		ServiceCode: aws.String(serviceCode),
+	})
+
+	if isAWSErr(err, servicequotas.ErrCodeNoSuchResourceException, "") {
+		log.Printf("[WARN] Service Quotas Template (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error getting Service Quotas Template (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.ServiceQuotaIncreaseRequestInTemplate == nil {
+		return fmt.Errorf("error getting Service Quotas Template (%s): empty result", d.Id())
+	}
+
+	request := output.ServiceQuotaIncreaseRequestInTemplate
+
+	d.Set("global_quota", request.GlobalQuota)
+	d.Set("quota_code", request.QuotaCode)
+	d.Set("quota_name", request.QuotaName)
+	d.Set("region", request.AwsRegion)
+	d.Set("service_code", request.ServiceCode)
+	d.Set("service_name", request.ServiceName)
+	d.Set("unit", request.Unit)
+	d.Set("value", request.DesiredValue)
+
+	return nil
+}
+
+func resourceAwsServiceQuotasTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).servicequotasconn
+
+	region, serviceCode, quotaCode, err := resourceAwsServiceQuotasTemplateParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &servicequotas.DeleteServiceQuotaIncreaseRequestFromTemplateInput{
+		AwsRegion:   aws.String(region),
+		QuotaCode:   aws.String(quotaCode),
+		ServiceCode: aws.String(serviceCode),
+	}
+
+	log.Printf("[DEBUG] Deleting Service Quotas Template: %s", input)
+	_, err = conn.DeleteServiceQuotaIncreaseRequestFromTemplate(input)
+
+	if isAWSErr(err, servicequotas.ErrCodeNoSuchResourceException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Service Quotas Template (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsServiceQuotasTemplateParseID(id string) (string, string, string, error) {
+	parts := strings.SplitN(id, "/", 3)
+
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("unexpected format of ID (%s), expected REGION/SERVICE-CODE/QUOTA-CODE", id)
+	}
+
+	return parts[0], parts[1], parts[2], nil
+}
@@ -0,0 +1,130 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAwsServiceQuotasTemplate_basic(t *testing.T) {
+	resourceName := "aws_servicequotas_template.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSServiceQuotasTemplate(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsServiceQuotasTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsServiceQuotasTemplateConfig("vpc", "L-F678F1CE", 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServiceQuotasTemplateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "global_quota", "false"),
+					resource.TestCheckResourceAttr(resourceName, "quota_code", "L-F678F1CE"),
+					resource.TestCheckResourceAttr(resourceName, "quota_name", "VPCs per Region"),
+					resource.TestCheckResourceAttr(resourceName, "region", testAccGetRegion()),
+					resource.TestCheckResourceAttr(resourceName, "service_code", "vpc"),
+					resource.TestCheckResourceAttr(resourceName, "value", "10"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAwsServiceQuotasTemplateConfig("vpc", "L-F678F1CE", 15),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsServiceQuotasTemplateExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "value", "15"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPreCheckAWSServiceQuotasTemplate(t *testing.T) {
+	conn := testAccProvider.Meta().(*AWSClient).servicequotasconn
+
+	_, err := conn.ListServiceQuotaIncreaseRequestsInTemplate(&servicequotas.ListServiceQuotaIncreaseRequestsInTemplateInput{})
+
+	if isAWSErr(err, servicequotas.ErrCodeNoAvailableOrganizationException, "") ||
+		isAWSErr(err, servicequotas.ErrCodeAWSServiceAccessNotEnabledException, "") ||
+		isAWSErr(err, servicequotas.ErrCodeAccessDeniedException, "") {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccCheckAwsServiceQuotasTemplateExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		region, serviceCode, quotaCode, err := resourceAwsServiceQuotasTemplateParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).servicequotasconn
+
+		_, err = conn.GetServiceQuotaIncreaseRequestFromTemplate(&servicequotas.GetServiceQuotaIncreaseRequestFromTemplateInput{
+			AwsRegion:   aws.String(region),
+			QuotaCode:   aws.String(quotaCode),
+			ServiceCode: aws.String(serviceCode),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAwsServiceQuotasTemplateDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).servicequotasconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_servicequotas_template" {
+			continue
+		}
+
+		region, serviceCode, quotaCode, err := resourceAwsServiceQuotasTemplateParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.GetServiceQuotaIncreaseRequestFromTemplate(&servicequotas.GetServiceQuotaIncreaseRequestFromTemplateInput{
+			AwsRegion:   aws.String(region),
+			QuotaCode:   aws.String(quotaCode),
+			ServiceCode: aws.String(serviceCode),
+		})
+
+		if isAWSErr(err, servicequotas.ErrCodeNoSuchResourceException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Service Quotas Template (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAwsServiceQuotasTemplateConfig(serviceCode, quotaCode string, value int) string {
+	return fmt.Sprintf(`
+resource "aws_servicequotas_template" "test" {
+  quota_code   = %[2]q
+  service_code = %[1]q
+  value        = %[3]d
+}
+`, serviceCode, quotaCode, value)
+}
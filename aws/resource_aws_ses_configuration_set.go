@@ -3,27 +3,80 @@ package aws
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ses"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceAwsSesConfigurationSet() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsSesConfigurationSetCreate,
 		Read:   resourceAwsSesConfigurationSetRead,
+		Update: resourceAwsSesConfigurationSetUpdate,
 		Delete: resourceAwsSesConfigurationSetDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
 		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"delivery_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tls_policy": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  ses.TlsPolicyOptional,
+							ValidateFunc: validation.StringInSlice([]string{
+								ses.TlsPolicyRequire,
+								ses.TlsPolicyOptional,
+							}, false),
+						},
+					},
+				},
+			},
+			"last_fresh_start": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
 			},
+			"reputation_metrics_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"sending_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"tracking_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"custom_redirect_domain": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -46,27 +99,165 @@ func resourceAwsSesConfigurationSetCreate(d *schema.ResourceData, meta interface
 
 	d.SetId(configurationSetName)
 
+	if v, ok := d.GetOk("delivery_options"); ok {
+		input := &ses.PutConfigurationSetDeliveryOptionsInput{
+			ConfigurationSetName: aws.String(d.Id()),
+			DeliveryOptions:      expandSesConfigurationSetDeliveryOptions(v.([]interface{})),
+		}
+
+		if _, err := conn.PutConfigurationSetDeliveryOptions(input); err != nil {
+			return fmt.Errorf("error setting SES configuration set (%s) delivery options: %s", d.Id(), err)
+		}
+	}
+
+	if v, ok := d.GetOk("tracking_options"); ok {
+		input := &ses.CreateConfigurationSetTrackingOptionsInput{
+			ConfigurationSetName: aws.String(d.Id()),
+			TrackingOptions:      expandSesConfigurationSetTrackingOptions(v.([]interface{})),
+		}
+
+		if _, err := conn.CreateConfigurationSetTrackingOptions(input); err != nil {
+			return fmt.Errorf("error creating SES configuration set (%s) tracking options: %s", d.Id(), err)
+		}
+	}
+
+	if v := d.Get("reputation_metrics_enabled").(bool); v {
+		if err := sesUpdateConfigurationSetReputationMetricsEnabled(conn, d.Id(), v); err != nil {
+			return err
+		}
+	}
+
+	if v := d.Get("sending_enabled").(bool); !v {
+		if err := sesUpdateConfigurationSetSendingEnabled(conn, d.Id(), v); err != nil {
+			return err
+		}
+	}
+
 	return resourceAwsSesConfigurationSetRead(d, meta)
 }
 
 func resourceAwsSesConfigurationSetRead(d *schema.ResourceData, meta interface{}) error {
-	configurationSetExists, err := findConfigurationSet(d.Id(), nil, meta)
+	conn := meta.(*AWSClient).sesConn
+
+	input := &ses.DescribeConfigurationSetInput{
+		ConfigurationSetName: aws.String(d.Id()),
+		ConfigurationSetAttributeNames: aws.StringSlice([]string{
+			ses.ConfigurationSetAttributeDeliveryOptions,
+			ses.ConfigurationSetAttributeReputationOptions,
+			ses.ConfigurationSetAttributeTrackingOptions,
+		}),
+	}
+
+	response, err := conn.DescribeConfigurationSet(input)
 
-	if !configurationSetExists {
+	if isAWSErr(err, ses.ErrCodeConfigurationSetDoesNotExistException, "") {
 		log.Printf("[WARN] SES Configuration Set (%s) not found", d.Id())
 		d.SetId("")
 		return nil
 	}
 
 	if err != nil {
-		return err
+		return fmt.Errorf("error describing SES configuration set (%s): %s", d.Id(), err)
 	}
 
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "ses",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("configuration-set/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
 	d.Set("name", d.Id())
 
+	if err := d.Set("delivery_options", flattenSesConfigurationSetDeliveryOptions(response.DeliveryOptions)); err != nil {
+		return fmt.Errorf("error setting delivery_options: %s", err)
+	}
+
+	if err := d.Set("tracking_options", flattenSesConfigurationSetTrackingOptions(response.TrackingOptions)); err != nil {
+		return fmt.Errorf("error setting tracking_options: %s", err)
+	}
+
+	d.Set("last_fresh_start", "")
+	d.Set("reputation_metrics_enabled", false)
+	d.Set("sending_enabled", true)
+	if options := response.ReputationOptions; options != nil {
+		if options.LastFreshStart != nil {
+			d.Set("last_fresh_start", aws.TimeValue(options.LastFreshStart).Format(time.RFC3339))
+		}
+		d.Set("reputation_metrics_enabled", options.ReputationMetricsEnabled)
+		d.Set("sending_enabled", options.SendingEnabled)
+	}
+
 	return nil
 }
 
+func resourceAwsSesConfigurationSetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesConn
+
+	if d.HasChange("delivery_options") {
+		input := &ses.PutConfigurationSetDeliveryOptionsInput{
+			ConfigurationSetName: aws.String(d.Id()),
+			DeliveryOptions:      expandSesConfigurationSetDeliveryOptions(d.Get("delivery_options").([]interface{})),
+		}
+
+		if _, err := conn.PutConfigurationSetDeliveryOptions(input); err != nil {
+			return fmt.Errorf("error setting SES configuration set (%s) delivery options: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tracking_options") {
+		o, n := d.GetChange("tracking_options")
+		oldOptions := o.([]interface{})
+		newOptions := n.([]interface{})
+
+		switch {
+		case len(newOptions) == 0:
+			input := &ses.DeleteConfigurationSetTrackingOptionsInput{
+				ConfigurationSetName: aws.String(d.Id()),
+			}
+
+			_, err := conn.DeleteConfigurationSetTrackingOptions(input)
+
+			if err != nil && !isAWSErr(err, ses.ErrCodeTrackingOptionsDoesNotExistException, "") {
+				return fmt.Errorf("error deleting SES configuration set (%s) tracking options: %s", d.Id(), err)
+			}
+		case len(oldOptions) == 0:
+			input := &ses.CreateConfigurationSetTrackingOptionsInput{
+				ConfigurationSetName: aws.String(d.Id()),
+				TrackingOptions:      expandSesConfigurationSetTrackingOptions(newOptions),
+			}
+
+			if _, err := conn.CreateConfigurationSetTrackingOptions(input); err != nil {
+				return fmt.Errorf("error creating SES configuration set (%s) tracking options: %s", d.Id(), err)
+			}
+		default:
+			input := &ses.UpdateConfigurationSetTrackingOptionsInput{
+				ConfigurationSetName: aws.String(d.Id()),
+				TrackingOptions:      expandSesConfigurationSetTrackingOptions(newOptions),
+			}
+
+			if _, err := conn.UpdateConfigurationSetTrackingOptions(input); err != nil {
+				return fmt.Errorf("error updating SES configuration set (%s) tracking options: %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("reputation_metrics_enabled") {
+		if err := sesUpdateConfigurationSetReputationMetricsEnabled(conn, d.Id(), d.Get("reputation_metrics_enabled").(bool)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("sending_enabled") {
+		if err := sesUpdateConfigurationSetSendingEnabled(conn, d.Id(), d.Get("sending_enabled").(bool)); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsSesConfigurationSetRead(d, meta)
+}
+
 func resourceAwsSesConfigurationSetDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).sesConn
 
@@ -78,29 +269,84 @@ func resourceAwsSesConfigurationSetDelete(d *schema.ResourceData, meta interface
 	return err
 }
 
-func findConfigurationSet(name string, token *string, meta interface{}) (bool, error) {
-	conn := meta.(*AWSClient).sesConn
+func sesUpdateConfigurationSetReputationMetricsEnabled(conn *ses.SES, name string, enabled bool) error {
+	input := &ses.UpdateConfigurationSetReputationMetricsEnabledInput{
+		ConfigurationSetName: aws.String(name),
+		Enabled:              aws.Bool(enabled),
+	}
 
-	configurationSetExists := false
+	if _, err := conn.UpdateConfigurationSetReputationMetricsEnabled(input); err != nil {
+		return fmt.Errorf("error updating SES configuration set (%s) reputation metrics: %s", name, err)
+	}
+
+	return nil
+}
 
-	listOpts := &ses.ListConfigurationSetsInput{
-		NextToken: token,
+func sesUpdateConfigurationSetSendingEnabled(conn *ses.SES, name string, enabled bool) error {
+	input := &ses.UpdateConfigurationSetSendingEnabledInput{
+		ConfigurationSetName: aws.String(name),
+		Enabled:              aws.Bool(enabled),
 	}
 
-	response, err := conn.ListConfigurationSets(listOpts)
-	for _, element := range response.ConfigurationSets {
-		if *element.Name == name {
-			configurationSetExists = true
-		}
+	if _, err := conn.UpdateConfigurationSetSendingEnabled(input); err != nil {
+		return fmt.Errorf("error updating SES configuration set (%s) sending: %s", name, err)
 	}
 
-	if err != nil && !configurationSetExists && response.NextToken != nil {
-		configurationSetExists, err = findConfigurationSet(name, response.NextToken, meta)
+	return nil
+}
+
+func expandSesConfigurationSetDeliveryOptions(l []interface{}) *ses.DeliveryOptions {
+	if len(l) == 0 || l[0] == nil {
+		return nil
 	}
 
-	if err != nil {
-		return false, err
+	m := l[0].(map[string]interface{})
+
+	options := &ses.DeliveryOptions{}
+
+	if v, ok := m["tls_policy"].(string); ok && v != "" {
+		options.TlsPolicy = aws.String(v)
+	}
+
+	return options
+}
+
+func flattenSesConfigurationSetDeliveryOptions(options *ses.DeliveryOptions) []interface{} {
+	if options == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"tls_policy": aws.StringValue(options.TlsPolicy),
+	}
+
+	return []interface{}{m}
+}
+
+func expandSesConfigurationSetTrackingOptions(l []interface{}) *ses.TrackingOptions {
+	options := &ses.TrackingOptions{}
+
+	if len(l) == 0 || l[0] == nil {
+		return options
+	}
+
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["custom_redirect_domain"].(string); ok && v != "" {
+		options.CustomRedirectDomain = aws.String(v)
+	}
+
+	return options
+}
+
+func flattenSesConfigurationSetTrackingOptions(options *ses.TrackingOptions) []interface{} {
+	if options == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"custom_redirect_domain": aws.StringValue(options.CustomRedirectDomain),
 	}
 
-	return configurationSetExists, nil
+	return []interface{}{m}
 }
@@ -2,8 +2,10 @@ package aws
 
 import (
 	"fmt"
+	"os"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ses"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
@@ -23,6 +25,11 @@ func TestAccAWSSESConfigurationSet_basic(t *testing.T) {
 				Config: testAccAWSSESConfigurationSetConfig,
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAwsSESConfigurationSetExists("aws_ses_configuration_set.test"),
+					testAccCheckResourceAttrRegionalARN("aws_ses_configuration_set.test", "arn", "ses", fmt.Sprintf("configuration-set/some-configuration-set-%d", escRandomInteger)),
+					resource.TestCheckResourceAttr("aws_ses_configuration_set.test", "delivery_options.#", "0"),
+					resource.TestCheckResourceAttr("aws_ses_configuration_set.test", "reputation_metrics_enabled", "false"),
+					resource.TestCheckResourceAttr("aws_ses_configuration_set.test", "sending_enabled", "true"),
+					resource.TestCheckResourceAttr("aws_ses_configuration_set.test", "tracking_options.#", "0"),
 				),
 			},
 			{
@@ -34,6 +41,127 @@ func TestAccAWSSESConfigurationSet_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSSESConfigurationSet_DeliveryOptions(t *testing.T) {
+	resourceName := "aws_ses_configuration_set.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAWSSES(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSESConfigurationSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSESConfigurationSetConfigDeliveryOptions(rName, ses.TlsPolicyRequire),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSESConfigurationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "delivery_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "delivery_options.0.tls_policy", ses.TlsPolicyRequire),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSESConfigurationSetConfigDeliveryOptions(rName, ses.TlsPolicyOptional),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSESConfigurationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "delivery_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "delivery_options.0.tls_policy", ses.TlsPolicyOptional),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSESConfigurationSet_TrackingOptions(t *testing.T) {
+	resourceName := "aws_ses_configuration_set.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	domain := testAccAwsSesConfigurationSetRedirectDomainFromEnv(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAWSSES(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSESConfigurationSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSESConfigurationSetConfigTrackingOptions(rName, domain),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSESConfigurationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tracking_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tracking_options.0.custom_redirect_domain", domain),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSESConfigurationSetConfigName(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSESConfigurationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tracking_options.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSESConfigurationSet_ReputationOptions(t *testing.T) {
+	resourceName := "aws_ses_configuration_set.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAWSSES(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSESConfigurationSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSESConfigurationSetConfigReputationOptions(rName, true, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSESConfigurationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "reputation_metrics_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "sending_enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSESConfigurationSetConfigReputationOptions(rName, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsSESConfigurationSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "reputation_metrics_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "sending_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAwsSesConfigurationSetRedirectDomainFromEnv(t *testing.T) string {
+	domain := os.Getenv("SES_CUSTOM_REDIRECT_DOMAIN")
+	if domain == "" {
+		t.Skip(
+			"Environment variable SES_CUSTOM_REDIRECT_DOMAIN is not set. " +
+				"Custom redirect domains must be verified SES identities.")
+	}
+	return domain
+}
+
 func testAccCheckSESConfigurationSetDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).sesConn
 
@@ -42,22 +170,19 @@ func testAccCheckSESConfigurationSetDestroy(s *terraform.State) error {
 			continue
 		}
 
-		response, err := conn.ListConfigurationSets(&ses.ListConfigurationSetsInput{})
-		if err != nil {
-			return err
-		}
+		_, err := conn.DescribeConfigurationSet(&ses.DescribeConfigurationSetInput{
+			ConfigurationSetName: aws.String(rs.Primary.ID),
+		})
 
-		found := false
-		for _, element := range response.ConfigurationSets {
-			if *element.Name == fmt.Sprintf("some-configuration-set-%d", escRandomInteger) {
-				found = true
-			}
+		if isAWSErr(err, ses.ErrCodeConfigurationSetDoesNotExistException, "") {
+			continue
 		}
 
-		if found {
-			return fmt.Errorf("The configuration set still exists")
+		if err != nil {
+			return err
 		}
 
+		return fmt.Errorf("The configuration set still exists")
 	}
 
 	return nil
@@ -77,23 +202,11 @@ func testAccCheckAwsSESConfigurationSetExists(n string) resource.TestCheckFunc {
 
 		conn := testAccProvider.Meta().(*AWSClient).sesConn
 
-		response, err := conn.ListConfigurationSets(&ses.ListConfigurationSetsInput{})
-		if err != nil {
-			return err
-		}
-
-		found := false
-		for _, element := range response.ConfigurationSets {
-			if *element.Name == fmt.Sprintf("some-configuration-set-%d", escRandomInteger) {
-				found = true
-			}
-		}
-
-		if !found {
-			return fmt.Errorf("The configuration set was not created")
-		}
+		_, err := conn.DescribeConfigurationSet(&ses.DescribeConfigurationSetInput{
+			ConfigurationSetName: aws.String(rs.Primary.ID),
+		})
 
-		return nil
+		return err
 	}
 }
 
@@ -103,3 +216,45 @@ resource "aws_ses_configuration_set" "test" {
     name = "some-configuration-set-%d"
 }
 `, escRandomInteger)
+
+func testAccAWSSESConfigurationSetConfigName(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ses_configuration_set" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSSESConfigurationSetConfigDeliveryOptions(rName, tlsPolicy string) string {
+	return fmt.Sprintf(`
+resource "aws_ses_configuration_set" "test" {
+  name = %[1]q
+
+  delivery_options {
+    tls_policy = %[2]q
+  }
+}
+`, rName, tlsPolicy)
+}
+
+func testAccAWSSESConfigurationSetConfigTrackingOptions(rName, domain string) string {
+	return fmt.Sprintf(`
+resource "aws_ses_configuration_set" "test" {
+  name = %[1]q
+
+  tracking_options {
+    custom_redirect_domain = %[2]q
+  }
+}
+`, rName, domain)
+}
+
+func testAccAWSSESConfigurationSetConfigReputationOptions(rName string, reputationMetricsEnabled, sendingEnabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_ses_configuration_set" "test" {
+  name                       = %[1]q
+  reputation_metrics_enabled = %[2]t
+  sending_enabled            = %[3]t
+}
+`, rName, reputationMetricsEnabled, sendingEnabled)
+}
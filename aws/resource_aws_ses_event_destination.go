@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ses"
@@ -16,7 +17,7 @@ func resourceAwsSesEventDestination() *schema.Resource {
 		Read:   resourceAwsSesEventDestinationRead,
 		Delete: resourceAwsSesEventDestinationDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceAwsSesEventDestinationImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -181,15 +182,79 @@ func resourceAwsSesEventDestinationCreate(d *schema.ResourceData, meta interface
 
 	d.SetId(eventDestinationName)
 
-	log.Printf("[WARN] SES DONE")
 	return resourceAwsSesEventDestinationRead(d, meta)
 }
 
 func resourceAwsSesEventDestinationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sesConn
+
+	configurationSetName := d.Get("configuration_set_name").(string)
+	input := &ses.DescribeConfigurationSetInput{
+		ConfigurationSetAttributeNames: aws.StringSlice([]string{ses.ConfigurationSetAttributeEventDestinations}),
+		ConfigurationSetName:           aws.String(configurationSetName),
+	}
+
+	output, err := conn.DescribeConfigurationSet(input)
+
+	if isAWSErr(err, ses.ErrCodeConfigurationSetDoesNotExistException, "") {
+		log.Printf("[WARN] SES Configuration Set (%s) not found, removing Event Destination (%s) from state", configurationSetName, d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error describing SES Configuration Set (%s): %s", configurationSetName, err)
+	}
+
+	var eventDestination *ses.EventDestination
+	for _, element := range output.EventDestinations {
+		if aws.StringValue(element.Name) == d.Id() {
+			eventDestination = element
+			break
+		}
+	}
+
+	if eventDestination == nil {
+		log.Printf("[WARN] SES Event Destination (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("enabled", eventDestination.Enabled)
+	d.Set("name", eventDestination.Name)
+
+	if err := d.Set("matching_types", flattenStringSet(eventDestination.MatchingEventTypes)); err != nil {
+		return fmt.Errorf("error setting matching_types: %s", err)
+	}
+
+	if err := d.Set("cloudwatch_destination", flattenSesCloudWatchDestination(eventDestination.CloudWatchDestination)); err != nil {
+		return fmt.Errorf("error setting cloudwatch_destination: %s", err)
+	}
+
+	if err := d.Set("kinesis_destination", flattenSesKinesisFirehoseDestination(eventDestination.KinesisFirehoseDestination)); err != nil {
+		return fmt.Errorf("error setting kinesis_destination: %s", err)
+	}
+
+	if err := d.Set("sns_destination", flattenSesSnsDestination(eventDestination.SNSDestination)); err != nil {
+		return fmt.Errorf("error setting sns_destination: %s", err)
+	}
 
 	return nil
 }
 
+func resourceAwsSesEventDestinationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected CONFIGURATION-SET-NAME/EVENT-DESTINATION-NAME", d.Id())
+	}
+
+	d.Set("configuration_set_name", parts[0])
+	d.SetId(parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsSesEventDestinationDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).sesConn
 
@@ -217,3 +282,46 @@ func generateCloudWatchDestination(v []interface{}) []*ses.CloudWatchDimensionCo
 
 	return b
 }
+
+func flattenSesCloudWatchDestination(destination *ses.CloudWatchDestination) []interface{} {
+	if destination == nil {
+		return []interface{}{}
+	}
+
+	l := make([]interface{}, 0, len(destination.DimensionConfigurations))
+
+	for _, dimension := range destination.DimensionConfigurations {
+		l = append(l, map[string]interface{}{
+			"default_value":  aws.StringValue(dimension.DefaultDimensionValue),
+			"dimension_name": aws.StringValue(dimension.DimensionName),
+			"value_source":   aws.StringValue(dimension.DimensionValueSource),
+		})
+	}
+
+	return l
+}
+
+func flattenSesKinesisFirehoseDestination(destination *ses.KinesisFirehoseDestination) []interface{} {
+	if destination == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"role_arn":   aws.StringValue(destination.IAMRoleARN),
+		"stream_arn": aws.StringValue(destination.DeliveryStreamARN),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenSesSnsDestination(destination *ses.SNSDestination) []interface{} {
+	if destination == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"topic_arn": aws.StringValue(destination.TopicARN),
+	}
+
+	return []interface{}{m}
+}
@@ -44,10 +44,39 @@ func TestAccAWSSESEventDestination_basic(t *testing.T) {
 						"aws_ses_event_destination.sns", "name", sesEventDstNameSns),
 				),
 			},
+			{
+				ResourceName:      "aws_ses_event_destination.kinesis",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSSESEventDestinationImportStateIdFunc("aws_ses_event_destination.kinesis"),
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "aws_ses_event_destination.cloudwatch",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSSESEventDestinationImportStateIdFunc("aws_ses_event_destination.cloudwatch"),
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "aws_ses_event_destination.sns",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSSESEventDestinationImportStateIdFunc("aws_ses_event_destination.sns"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
 
+func testAccAWSSESEventDestinationImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["configuration_set_name"], rs.Primary.ID), nil
+	}
+}
+
 func testAccCheckSESEventDestinationDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).sesConn
 
@@ -0,0 +1,376 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsSsmOpsItem() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSsmOpsItemCreate,
+		Read:   resourceAwsSsmOpsItemRead,
+		Update: resourceAwsSsmOpsItemUpdate,
+		Delete: resourceAwsSsmOpsItemDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"last_modified_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_modified_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"notification_arns": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateArn,
+				},
+			},
+			"operational_data": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  ssm.OpsItemDataTypeString,
+							ValidateFunc: validation.StringInSlice([]string{
+								ssm.OpsItemDataTypeSearchableString,
+								ssm.OpsItemDataTypeString,
+							}, false),
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 5),
+			},
+			"related_ops_item_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"source": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  ssm.OpsItemStatusOpen,
+				ValidateFunc: validation.StringInSlice([]string{
+					ssm.OpsItemStatusOpen,
+					ssm.OpsItemStatusInProgress,
+					ssm.OpsItemStatusResolved,
+				}, false),
+			},
+			"tags": tagsSchema(),
+			"title": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsSsmOpsItemCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ssmconn
+
+	input := &ssm.CreateOpsItemInput{
+		Description:     aws.String(d.Get("description").(string)),
+		Notifications:   expandSsmOpsItemNotifications(d.Get("notification_arns").([]interface{})),
+		OperationalData: expandSsmOpsItemOperationalData(d.Get("operational_data").(*schema.Set).List()),
+		RelatedOpsItems: expandSsmRelatedOpsItems(d.Get("related_ops_item_ids").([]interface{})),
+		Source:          aws.String(d.Get("source").(string)),
+		Title:           aws.String(d.Get("title").(string)),
+	}
+
+	if v, ok := d.GetOk("priority"); ok {
+		input.Priority = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		input.Tags = tagsFromMapSSM(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating SSM OpsItem: %s", input)
+	output, err := conn.CreateOpsItem(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating SSM OpsItem: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.OpsItemId))
+
+	// New OpsItems are always created as Open.
+	if v := d.Get("status").(string); v != ssm.OpsItemStatusOpen {
+		updateInput := &ssm.UpdateOpsItemInput{
+			OpsItemId: aws.String(d.Id()),
+			Status:    aws.String(v),
+		}
+
+		if _, err := conn.UpdateOpsItem(updateInput); err != nil {
+			return fmt.Errorf("error updating SSM OpsItem (%s) status: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsSsmOpsItemRead(d, meta)
+}
+
+func resourceAwsSsmOpsItemRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ssmconn
+
+	output, err := conn.GetOpsItem(&ssm.GetOpsItemInput{
+		OpsItemId: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, ssm.ErrCodeOpsItemNotFoundException, "") {
+		log.Printf("[WARN] SSM OpsItem (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SSM OpsItem (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.OpsItem == nil {
+		return fmt.Errorf("error reading SSM OpsItem (%s): empty result", d.Id())
+	}
+
+	opsItem := output.OpsItem
+
+	arn := arn.ARN{
+		Partition: meta.(*AWSClient).partition,
+		Service:   "ssm",
+		Region:    meta.(*AWSClient).region,
+		AccountID: meta.(*AWSClient).accountid,
+		Resource:  fmt.Sprintf("opsitem/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+	d.Set("created_by", opsItem.CreatedBy)
+	if opsItem.CreatedTime != nil {
+		d.Set("created_time", aws.TimeValue(opsItem.CreatedTime).Format(time.RFC3339))
+	}
+	d.Set("description", opsItem.Description)
+	d.Set("last_modified_by", opsItem.LastModifiedBy)
+	if opsItem.LastModifiedTime != nil {
+		d.Set("last_modified_time", aws.TimeValue(opsItem.LastModifiedTime).Format(time.RFC3339))
+	}
+	d.Set("priority", opsItem.Priority)
+	d.Set("source", opsItem.Source)
+	d.Set("status", opsItem.Status)
+	d.Set("title", opsItem.Title)
+	d.Set("version", opsItem.Version)
+
+	if err := d.Set("notification_arns", flattenSsmOpsItemNotifications(opsItem.Notifications)); err != nil {
+		return fmt.Errorf("error setting notification_arns: %s", err)
+	}
+
+	if err := d.Set("operational_data", flattenSsmOpsItemOperationalData(opsItem.OperationalData)); err != nil {
+		return fmt.Errorf("error setting operational_data: %s", err)
+	}
+
+	if err := d.Set("related_ops_item_ids", flattenSsmRelatedOpsItems(opsItem.RelatedOpsItems)); err != nil {
+		return fmt.Errorf("error setting related_ops_item_ids: %s", err)
+	}
+
+	if err := saveTagsSSM(conn, d, d.Id(), ssm.ResourceTypeForTaggingOpsItem); err != nil {
+		return fmt.Errorf("error saving tags for SSM OpsItem (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsSsmOpsItemUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ssmconn
+
+	input := &ssm.UpdateOpsItemInput{
+		Description:     aws.String(d.Get("description").(string)),
+		Notifications:   expandSsmOpsItemNotifications(d.Get("notification_arns").([]interface{})),
+		OperationalData: expandSsmOpsItemOperationalData(d.Get("operational_data").(*schema.Set).List()),
+		OpsItemId:       aws.String(d.Id()),
+		RelatedOpsItems: expandSsmRelatedOpsItems(d.Get("related_ops_item_ids").([]interface{})),
+		Status:          aws.String(d.Get("status").(string)),
+		Title:           aws.String(d.Get("title").(string)),
+	}
+
+	if v, ok := d.GetOk("priority"); ok {
+		input.Priority = aws.Int64(int64(v.(int)))
+	}
+
+	if d.HasChange("operational_data") {
+		o, n := d.GetChange("operational_data")
+		newKeys := make(map[string]bool)
+		for _, v := range n.(*schema.Set).List() {
+			newKeys[v.(map[string]interface{})["key"].(string)] = true
+		}
+		for _, v := range o.(*schema.Set).List() {
+			key := v.(map[string]interface{})["key"].(string)
+			if !newKeys[key] {
+				input.OperationalDataToDelete = append(input.OperationalDataToDelete, aws.String(key))
+			}
+		}
+	}
+
+	log.Printf("[DEBUG] Updating SSM OpsItem: %s", input)
+	if _, err := conn.UpdateOpsItem(input); err != nil {
+		return fmt.Errorf("error updating SSM OpsItem (%s): %s", d.Id(), err)
+	}
+
+	if d.HasChange("tags") {
+		if err := setTagsSSM(conn, d, d.Id(), ssm.ResourceTypeForTaggingOpsItem); err != nil {
+			return fmt.Errorf("error setting tags for SSM OpsItem (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsSsmOpsItemRead(d, meta)
+}
+
+// OpsItems cannot be deleted, so destroying the resource resolves the OpsItem.
+func resourceAwsSsmOpsItemDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ssmconn
+
+	input := &ssm.UpdateOpsItemInput{
+		OpsItemId: aws.String(d.Id()),
+		Status:    aws.String(ssm.OpsItemStatusResolved),
+	}
+
+	log.Printf("[DEBUG] Resolving SSM OpsItem: %s", input)
+	_, err := conn.UpdateOpsItem(input)
+
+	if isAWSErr(err, ssm.ErrCodeOpsItemNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error resolving SSM OpsItem (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandSsmOpsItemNotifications(l []interface{}) []*ssm.OpsItemNotification {
+	notifications := make([]*ssm.OpsItemNotification, 0, len(l))
+
+	for _, v := range l {
+		notifications = append(notifications, &ssm.OpsItemNotification{
+			Arn: aws.String(v.(string)),
+		})
+	}
+
+	return notifications
+}
+
+func flattenSsmOpsItemNotifications(notifications []*ssm.OpsItemNotification) []interface{} {
+	l := make([]interface{}, 0, len(notifications))
+
+	for _, notification := range notifications {
+		l = append(l, aws.StringValue(notification.Arn))
+	}
+
+	return l
+}
+
+func expandSsmOpsItemOperationalData(l []interface{}) map[string]*ssm.OpsItemDataValue {
+	if len(l) == 0 {
+		return nil
+	}
+
+	data := make(map[string]*ssm.OpsItemDataValue, len(l))
+
+	for _, v := range l {
+		m := v.(map[string]interface{})
+		data[m["key"].(string)] = &ssm.OpsItemDataValue{
+			Type:  aws.String(m["type"].(string)),
+			Value: aws.String(m["value"].(string)),
+		}
+	}
+
+	return data
+}
+
+func flattenSsmOpsItemOperationalData(data map[string]*ssm.OpsItemDataValue) []interface{} {
+	l := make([]interface{}, 0, len(data))
+
+	for k, v := range data {
+		l = append(l, map[string]interface{}{
+			"key":   k,
+			"type":  aws.StringValue(v.Type),
+			"value": aws.StringValue(v.Value),
+		})
+	}
+
+	return l
+}
+
+func expandSsmRelatedOpsItems(l []interface{}) []*ssm.RelatedOpsItem {
+	items := make([]*ssm.RelatedOpsItem, 0, len(l))
+
+	for _, v := range l {
+		items = append(items, &ssm.RelatedOpsItem{
+			OpsItemId: aws.String(v.(string)),
+		})
+	}
+
+	return items
+}
+
+func flattenSsmRelatedOpsItems(items []*ssm.RelatedOpsItem) []interface{} {
+	l := make([]interface{}, 0, len(items))
+
+	for _, item := range items {
+		l = append(l, aws.StringValue(item.OpsItemId))
+	}
+
+	return l
+}
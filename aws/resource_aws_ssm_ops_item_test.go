@@ -0,0 +1,134 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSSsmOpsItem_basic(t *testing.T) {
+	var opsItem ssm.OpsItem
+	resourceName := "aws_ssm_ops_item.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSsmOpsItemResolved,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSsmOpsItemConfig(rName, "description1", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSsmOpsItemExists(resourceName, &opsItem),
+					testAccMatchResourceAttrRegionalARN(resourceName, "arn", "ssm", regexp.MustCompile(`opsitem/oi-.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "created_by"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_time"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(resourceName, "operational_data.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "priority", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source", "terraform"),
+					resource.TestCheckResourceAttr(resourceName, "status", ssm.OpsItemStatusOpen),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+					resource.TestCheckResourceAttr(resourceName, "title", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSsmOpsItemConfig(rName, "description2", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSsmOpsItemExists(resourceName, &opsItem),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+					resource.TestCheckResourceAttr(resourceName, "priority", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSsmOpsItemExists(resourceName string, opsItem *ssm.OpsItem) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SSM OpsItem ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ssmconn
+
+		output, err := conn.GetOpsItem(&ssm.GetOpsItemInput{
+			OpsItemId: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		*opsItem = *output.OpsItem
+
+		return nil
+	}
+}
+
+// OpsItems cannot be deleted, so check that destroyed OpsItems were resolved.
+func testAccCheckAWSSsmOpsItemResolved(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ssmconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ssm_ops_item" {
+			continue
+		}
+
+		output, err := conn.GetOpsItem(&ssm.GetOpsItemInput{
+			OpsItemId: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, ssm.ErrCodeOpsItemNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if status := aws.StringValue(output.OpsItem.Status); status != ssm.OpsItemStatusResolved {
+			return fmt.Errorf("SSM OpsItem (%s) status is %q, expected %q", rs.Primary.ID, status, ssm.OpsItemStatusResolved)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSSsmOpsItemConfig(rName, description string, priority int) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_ops_item" "test" {
+  description = %[2]q
+  priority    = %[3]d
+  source      = "terraform"
+  title       = %[1]q
+
+  operational_data {
+    key   = "runbook"
+    type  = "SearchableString"
+    value = "https://example.com/runbooks/%[1]s"
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, description, priority)
+}
@@ -0,0 +1,194 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	ssmServiceSettingStatusCustomized    = "Customized"
+	ssmServiceSettingStatusDefault       = "Default"
+	ssmServiceSettingStatusPendingUpdate = "PendingUpdate"
+)
+
+func resourceAwsSsmServiceSetting() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSsmServiceSettingUpdate,
+		Read:   resourceAwsSsmServiceSettingRead,
+		Update: resourceAwsSsmServiceSettingUpdate,
+		Delete: resourceAwsSsmServiceSettingDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"setting_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"setting_value": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsSsmServiceSettingUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ssmconn
+	settingID := d.Get("setting_id").(string)
+
+	input := &ssm.UpdateServiceSettingInput{
+		SettingId:    aws.String(settingID),
+		SettingValue: aws.String(d.Get("setting_value").(string)),
+	}
+
+	log.Printf("[DEBUG] Updating SSM Service Setting: %s", input)
+	if _, err := conn.UpdateServiceSetting(input); err != nil {
+		return fmt.Errorf("error updating SSM Service Setting (%s): %s", settingID, err)
+	}
+
+	d.SetId(settingID)
+
+	if err := waitForSsmServiceSettingUpdate(conn, d.Id(), d.Get("setting_value").(string)); err != nil {
+		return fmt.Errorf("error waiting for SSM Service Setting (%s) update: %s", d.Id(), err)
+	}
+
+	return resourceAwsSsmServiceSettingRead(d, meta)
+}
+
+func resourceAwsSsmServiceSettingRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ssmconn
+
+	output, err := conn.GetServiceSetting(&ssm.GetServiceSettingInput{
+		SettingId: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, ssm.ErrCodeServiceSettingNotFound, "") {
+		log.Printf("[WARN] SSM Service Setting (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SSM Service Setting (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.ServiceSetting == nil {
+		return fmt.Errorf("error reading SSM Service Setting (%s): empty result", d.Id())
+	}
+
+	setting := output.ServiceSetting
+
+	d.Set("arn", setting.ARN)
+	// The API returns the setting ID as an ARN, while it can be configured
+	// either as an ARN or as a path, so keep the configured form.
+	d.Set("setting_id", d.Id())
+	d.Set("setting_value", setting.SettingValue)
+	d.Set("status", setting.Status)
+
+	return nil
+}
+
+func resourceAwsSsmServiceSettingDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ssmconn
+
+	input := &ssm.ResetServiceSettingInput{
+		SettingId: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Resetting SSM Service Setting: %s", input)
+	_, err := conn.ResetServiceSetting(input)
+
+	if isAWSErr(err, ssm.ErrCodeServiceSettingNotFound, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error resetting SSM Service Setting (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForSsmServiceSettingReset(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for SSM Service Setting (%s) reset: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func ssmServiceSettingStatusRefreshFunc(conn *ssm.SSM, settingID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.GetServiceSetting(&ssm.GetServiceSettingInput{
+			SettingId: aws.String(settingID),
+		})
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil || output.ServiceSetting == nil {
+			return nil, "", nil
+		}
+
+		return output.ServiceSetting, aws.StringValue(output.ServiceSetting.Status), nil
+	}
+}
+
+// ssmServiceSettingValueRefreshFunc reports the setting as pending until it
+// holds the expected value, as the status may still reflect the previous one.
+func ssmServiceSettingValueRefreshFunc(conn *ssm.SSM, settingID, settingValue string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		setting, status, err := ssmServiceSettingStatusRefreshFunc(conn, settingID)()
+
+		if err != nil || setting == nil {
+			return setting, status, err
+		}
+
+		if aws.StringValue(setting.(*ssm.ServiceSetting).SettingValue) != settingValue {
+			return setting, ssmServiceSettingStatusPendingUpdate, nil
+		}
+
+		return setting, status, nil
+	}
+}
+
+func waitForSsmServiceSettingUpdate(conn *ssm.SSM, settingID, settingValue string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ssmServiceSettingStatusPendingUpdate, ""},
+		Target:  []string{ssmServiceSettingStatusCustomized, ssmServiceSettingStatusDefault},
+		Refresh: ssmServiceSettingValueRefreshFunc(conn, settingID, settingValue),
+		Timeout: 2 * time.Minute,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
+
+func waitForSsmServiceSettingReset(conn *ssm.SSM, settingID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ssmServiceSettingStatusPendingUpdate, ssmServiceSettingStatusCustomized, ""},
+		Target:  []string{ssmServiceSettingStatusDefault},
+		Refresh: ssmServiceSettingStatusRefreshFunc(conn, settingID),
+		Timeout: 2 * time.Minute,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
@@ -0,0 +1,101 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSSsmServiceSetting_basic(t *testing.T) {
+	resourceName := "aws_ssm_service_setting.test"
+	settingID := "/ssm/parameter-store/default-parameter-tier"
+
+	// Service settings are account-wide, so these tests cannot run in parallel.
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSsmServiceSettingReset(settingID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSsmServiceSettingConfig(settingID, ssm.ParameterTierAdvanced),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSsmServiceSettingValue(resourceName, ssm.ParameterTierAdvanced),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "setting_id", settingID),
+					resource.TestCheckResourceAttr(resourceName, "setting_value", ssm.ParameterTierAdvanced),
+					resource.TestCheckResourceAttr(resourceName, "status", "Customized"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSsmServiceSettingConfig(settingID, ssm.ParameterTierStandard),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSsmServiceSettingValue(resourceName, ssm.ParameterTierStandard),
+					resource.TestCheckResourceAttr(resourceName, "setting_value", ssm.ParameterTierStandard),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSsmServiceSettingValue(resourceName, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ssmconn
+
+		output, err := conn.GetServiceSetting(&ssm.GetServiceSettingInput{
+			SettingId: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if actual := aws.StringValue(output.ServiceSetting.SettingValue); actual != value {
+			return fmt.Errorf("SSM Service Setting (%s) value is %q, expected %q", rs.Primary.ID, actual, value)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAWSSsmServiceSettingReset(settingID string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).ssmconn
+
+		output, err := conn.GetServiceSetting(&ssm.GetServiceSettingInput{
+			SettingId: aws.String(settingID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if status := aws.StringValue(output.ServiceSetting.Status); status != ssmServiceSettingStatusDefault {
+			return fmt.Errorf("SSM Service Setting (%s) status is %q, expected %q", settingID, status, ssmServiceSettingStatusDefault)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSSsmServiceSettingConfig(settingID, value string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_service_setting" "test" {
+  setting_id    = %[1]q
+  setting_value = %[2]q
+}
+`, settingID, value)
+}
@@ -152,21 +152,8 @@ func resourceAwsStorageGatewayCachedIscsiVolumeRead(d *schema.ResourceData, meta
 	d.Set("volume_id", aws.StringValue(volume.VolumeId))
 	d.Set("volume_size_in_bytes", int(aws.Int64Value(volume.VolumeSizeInBytes)))
 
-	if volume.VolumeiSCSIAttributes != nil {
-		d.Set("chap_enabled", aws.BoolValue(volume.VolumeiSCSIAttributes.ChapEnabled))
-		d.Set("lun_number", int(aws.Int64Value(volume.VolumeiSCSIAttributes.LunNumber)))
-		d.Set("network_interface_id", aws.StringValue(volume.VolumeiSCSIAttributes.NetworkInterfaceId))
-		d.Set("network_interface_port", int(aws.Int64Value(volume.VolumeiSCSIAttributes.NetworkInterfacePort)))
-
-		targetARN := aws.StringValue(volume.VolumeiSCSIAttributes.TargetARN)
-		d.Set("target_arn", targetARN)
-
-		gatewayARN, targetName, err := parseStorageGatewayVolumeGatewayARNAndTargetNameFromARN(targetARN)
-		if err != nil {
-			return fmt.Errorf("error parsing Storage Gateway volume gateway ARN and target name from target ARN %q: %s", targetARN, err)
-		}
-		d.Set("gateway_arn", gatewayARN)
-		d.Set("target_name", targetName)
+	if err := setStorageGatewayVolumeiSCSIAttributes(d, volume.VolumeiSCSIAttributes); err != nil {
+		return err
 	}
 
 	return nil
@@ -175,12 +162,44 @@ func resourceAwsStorageGatewayCachedIscsiVolumeRead(d *schema.ResourceData, meta
 func resourceAwsStorageGatewayCachedIscsiVolumeDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).storagegatewayconn
 
+	log.Printf("[DEBUG] Deleting Storage Gateway cached iSCSI volume: %s", d.Id())
+	if err := deleteStorageGatewayVolume(conn, d.Id()); err != nil {
+		return fmt.Errorf("error deleting Storage Gateway cached iSCSI volume %q: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// setStorageGatewayVolumeiSCSIAttributes sets the iSCSI target attributes shared by cached and stored volumes
+func setStorageGatewayVolumeiSCSIAttributes(d *schema.ResourceData, attributes *storagegateway.VolumeiSCSIAttributes) error {
+	if attributes == nil {
+		return nil
+	}
+
+	d.Set("chap_enabled", aws.BoolValue(attributes.ChapEnabled))
+	d.Set("lun_number", int(aws.Int64Value(attributes.LunNumber)))
+	d.Set("network_interface_id", aws.StringValue(attributes.NetworkInterfaceId))
+	d.Set("network_interface_port", int(aws.Int64Value(attributes.NetworkInterfacePort)))
+
+	targetARN := aws.StringValue(attributes.TargetARN)
+	d.Set("target_arn", targetARN)
+
+	gatewayARN, targetName, err := parseStorageGatewayVolumeGatewayARNAndTargetNameFromARN(targetARN)
+	if err != nil {
+		return fmt.Errorf("error parsing Storage Gateway volume gateway ARN and target name from target ARN %q: %s", targetARN, err)
+	}
+	d.Set("gateway_arn", gatewayARN)
+	d.Set("target_name", targetName)
+
+	return nil
+}
+
+func deleteStorageGatewayVolume(conn *storagegateway.StorageGateway, volumeARN string) error {
 	input := &storagegateway.DeleteVolumeInput{
-		VolumeARN: aws.String(d.Id()),
+		VolumeARN: aws.String(volumeARN),
 	}
 
-	log.Printf("[DEBUG] Deleting Storage Gateway cached iSCSI volume: %s", input)
-	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+	return resource.Retry(2*time.Minute, func() *resource.RetryError {
 		_, err := conn.DeleteVolume(input)
 		if err != nil {
 			if isAWSErr(err, storagegateway.ErrorCodeVolumeNotFound, "") {
@@ -195,11 +214,6 @@ func resourceAwsStorageGatewayCachedIscsiVolumeDelete(d *schema.ResourceData, me
 		}
 		return nil
 	})
-	if err != nil {
-		return fmt.Errorf("error deleting Storage Gateway cached iSCSI volume %q: %s", d.Id(), err)
-	}
-
-	return nil
 }
 
 func parseStorageGatewayVolumeGatewayARNAndTargetNameFromARN(inputARN string) (string, string, error) {
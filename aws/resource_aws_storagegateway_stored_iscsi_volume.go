@@ -0,0 +1,172 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsStorageGatewayStoredIscsiVolume() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsStorageGatewayStoredIscsiVolumeCreate,
+		Read:   resourceAwsStorageGatewayStoredIscsiVolumeRead,
+		Delete: resourceAwsStorageGatewayStoredIscsiVolumeDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"chap_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"disk_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"gateway_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"lun_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			// Poor API naming: this accepts the IP address of the network interface
+			"network_interface_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"network_interface_port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"preserve_existing_data": {
+				Type:     schema.TypeBool,
+				Required: true,
+				ForceNew: true,
+			},
+			"snapshot_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"target_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"target_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"volume_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"volume_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"volume_size_in_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"volume_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsStorageGatewayStoredIscsiVolumeCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).storagegatewayconn
+
+	input := &storagegateway.CreateStorediSCSIVolumeInput{
+		DiskId:               aws.String(d.Get("disk_id").(string)),
+		GatewayARN:           aws.String(d.Get("gateway_arn").(string)),
+		NetworkInterfaceId:   aws.String(d.Get("network_interface_id").(string)),
+		PreserveExistingData: aws.Bool(d.Get("preserve_existing_data").(bool)),
+		TargetName:           aws.String(d.Get("target_name").(string)),
+	}
+
+	if v, ok := d.GetOk("snapshot_id"); ok {
+		input.SnapshotId = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Storage Gateway stored iSCSI volume: %s", input)
+	output, err := conn.CreateStorediSCSIVolume(input)
+	if err != nil {
+		return fmt.Errorf("error creating Storage Gateway stored iSCSI volume: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.VolumeARN))
+
+	return resourceAwsStorageGatewayStoredIscsiVolumeRead(d, meta)
+}
+
+func resourceAwsStorageGatewayStoredIscsiVolumeRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).storagegatewayconn
+
+	input := &storagegateway.DescribeStorediSCSIVolumesInput{
+		VolumeARNs: []*string{aws.String(d.Id())},
+	}
+
+	log.Printf("[DEBUG] Reading Storage Gateway stored iSCSI volume: %s", input)
+	output, err := conn.DescribeStorediSCSIVolumes(input)
+
+	if err != nil {
+		if isAWSErr(err, storagegateway.ErrorCodeVolumeNotFound, "") {
+			log.Printf("[WARN] Storage Gateway stored iSCSI volume %q not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading Storage Gateway stored iSCSI volume %q: %s", d.Id(), err)
+	}
+
+	if output == nil || len(output.StorediSCSIVolumes) == 0 || output.StorediSCSIVolumes[0] == nil || aws.StringValue(output.StorediSCSIVolumes[0].VolumeARN) != d.Id() {
+		log.Printf("[WARN] Storage Gateway stored iSCSI volume %q not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	volume := output.StorediSCSIVolumes[0]
+
+	d.Set("arn", aws.StringValue(volume.VolumeARN))
+	d.Set("disk_id", aws.StringValue(volume.VolumeDiskId))
+	d.Set("preserve_existing_data", aws.BoolValue(volume.PreservedExistingData))
+	d.Set("snapshot_id", aws.StringValue(volume.SourceSnapshotId))
+	d.Set("volume_arn", aws.StringValue(volume.VolumeARN))
+	d.Set("volume_id", aws.StringValue(volume.VolumeId))
+	d.Set("volume_size_in_bytes", int(aws.Int64Value(volume.VolumeSizeInBytes)))
+	d.Set("volume_status", aws.StringValue(volume.VolumeStatus))
+
+	if err := setStorageGatewayVolumeiSCSIAttributes(d, volume.VolumeiSCSIAttributes); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAwsStorageGatewayStoredIscsiVolumeDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).storagegatewayconn
+
+	log.Printf("[DEBUG] Deleting Storage Gateway stored iSCSI volume: %s", d.Id())
+	if err := deleteStorageGatewayVolume(conn, d.Id()); err != nil {
+		return fmt.Errorf("error deleting Storage Gateway stored iSCSI volume %q: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,312 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsStorageGatewayTape() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsStorageGatewayTapeCreate,
+		Read:   resourceAwsStorageGatewayTapeRead,
+		Delete: resourceAwsStorageGatewayTapeDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"gateway_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"kms_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"pool_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"tape_barcode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tape_barcode_prefix": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				// The prefix only applies at creation, so an existing tape whose
+				// barcode already starts with the configured prefix is up to date
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					barcode := d.Get("tape_barcode").(string)
+					return barcode != "" && strings.HasPrefix(barcode, new)
+				},
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[A-Z]{1,4}$`),
+					"must be between 1 and 4 uppercase letters",
+				),
+			},
+			"tape_size_in_bytes": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"tape_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tape_used_in_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsStorageGatewayTapeCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).storagegatewayconn
+
+	gatewayARN := d.Get("gateway_arn").(string)
+
+	input := &storagegateway.CreateTapesInput{
+		ClientToken:       aws.String(resource.UniqueId()),
+		GatewayARN:        aws.String(gatewayARN),
+		NumTapesToCreate:  aws.Int64(1),
+		TapeBarcodePrefix: aws.String(d.Get("tape_barcode_prefix").(string)),
+		TapeSizeInBytes:   aws.Int64(int64(d.Get("tape_size_in_bytes").(int))),
+	}
+
+	if v, ok := d.GetOk("kms_key_arn"); ok {
+		input.KMSEncrypted = aws.Bool(true)
+		input.KMSKey = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("pool_id"); ok {
+		input.PoolId = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Storage Gateway tape: %s", input)
+	output, err := conn.CreateTapes(input)
+	if err != nil {
+		return fmt.Errorf("error creating Storage Gateway tape: %s", err)
+	}
+
+	if output == nil || len(output.TapeARNs) == 0 {
+		return fmt.Errorf("error creating Storage Gateway tape: empty response")
+	}
+
+	tapeARN := aws.StringValue(output.TapeARNs[0])
+	barcode, err := parseStorageGatewayTapeBarcodeFromARN(tapeARN)
+	if err != nil {
+		return fmt.Errorf("error parsing Storage Gateway tape barcode from ARN %q: %s", tapeARN, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", gatewayARN, barcode))
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"CREATING"},
+		Target:  []string{"AVAILABLE"},
+		Refresh: storageGatewayTapeStatusRefreshFunc(conn, gatewayARN, tapeARN),
+		Timeout: d.Timeout(schema.TimeoutCreate),
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for Storage Gateway tape %q to be available: %s", d.Id(), err)
+	}
+
+	return resourceAwsStorageGatewayTapeRead(d, meta)
+}
+
+func resourceAwsStorageGatewayTapeRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).storagegatewayconn
+
+	gatewayARN, tapeARN, err := decodeStorageGatewayTapeID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	tape, err := describeStorageGatewayTape(conn, gatewayARN, tapeARN)
+	if err != nil {
+		if isAWSErrStorageGatewayGatewayNotFound(err) {
+			log.Printf("[WARN] Storage Gateway tape %q not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading Storage Gateway tape %q: %s", d.Id(), err)
+	}
+
+	if tape == nil {
+		log.Printf("[WARN] Storage Gateway tape %q not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("arn", aws.StringValue(tape.TapeARN))
+	d.Set("gateway_arn", gatewayARN)
+	d.Set("kms_key_arn", aws.StringValue(tape.KMSKey))
+	d.Set("pool_id", aws.StringValue(tape.PoolId))
+	d.Set("tape_barcode", aws.StringValue(tape.TapeBarcode))
+	// The API does not return the prefix, e.g. after import
+	if _, ok := d.GetOk("tape_barcode_prefix"); !ok {
+		d.Set("tape_barcode_prefix", storageGatewayTapeBarcodePrefix(aws.StringValue(tape.TapeBarcode)))
+	}
+	d.Set("tape_size_in_bytes", int(aws.Int64Value(tape.TapeSizeInBytes)))
+	d.Set("tape_status", aws.StringValue(tape.TapeStatus))
+	d.Set("tape_used_in_bytes", int(aws.Int64Value(tape.TapeUsedInBytes)))
+
+	return nil
+}
+
+func resourceAwsStorageGatewayTapeDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).storagegatewayconn
+
+	gatewayARN, tapeARN, err := decodeStorageGatewayTapeID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &storagegateway.DeleteTapeInput{
+		GatewayARN: aws.String(gatewayARN),
+		TapeARN:    aws.String(tapeARN),
+	}
+
+	log.Printf("[DEBUG] Deleting Storage Gateway tape: %s", input)
+	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+		_, err := conn.DeleteTape(input)
+		if err != nil {
+			if isAWSErrStorageGatewayGatewayNotFound(err) {
+				return nil
+			}
+			if isAWSErr(err, storagegateway.ErrorCodeTapeCartridgeNotFound, "") {
+				return nil
+			}
+			// InvalidGatewayRequestException: The specified gateway is not connected.
+			if isAWSErr(err, storagegateway.ErrCodeInvalidGatewayRequestException, "The specified gateway is not connected") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error deleting Storage Gateway tape %q: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func describeStorageGatewayTape(conn *storagegateway.StorageGateway, gatewayARN, tapeARN string) (*storagegateway.Tape, error) {
+	input := &storagegateway.DescribeTapesInput{
+		GatewayARN: aws.String(gatewayARN),
+		TapeARNs:   []*string{aws.String(tapeARN)},
+	}
+
+	log.Printf("[DEBUG] Reading Storage Gateway tape: %s", input)
+	output, err := conn.DescribeTapes(input)
+	if err != nil {
+		if isAWSErr(err, storagegateway.ErrorCodeTapeCartridgeNotFound, "") {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	for _, tape := range output.Tapes {
+		if tape != nil && aws.StringValue(tape.TapeARN) == tapeARN {
+			return tape, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func storageGatewayTapeStatusRefreshFunc(conn *storagegateway.StorageGateway, gatewayARN, tapeARN string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		tape, err := describeStorageGatewayTape(conn, gatewayARN, tapeARN)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if tape == nil {
+			return nil, "", nil
+		}
+
+		return tape, aws.StringValue(tape.TapeStatus), nil
+	}
+}
+
+func parseStorageGatewayTapeBarcodeFromARN(inputARN string) (string, error) {
+	// inputARN = arn:aws:storagegateway:us-east-1:123456789012:tape/TEST0AA2AF
+	tapeARN, err := arn.Parse(inputARN)
+	if err != nil {
+		return "", err
+	}
+	resourceParts := strings.SplitN(tapeARN.Resource, "/", 2)
+	if len(resourceParts) != 2 || resourceParts[0] != "tape" || resourceParts[1] == "" {
+		return "", fmt.Errorf("expected resource format tape/TEST0AA2AF, received: %s", tapeARN.Resource)
+	}
+	return resourceParts[1], nil
+}
+
+// storageGatewayTapeBarcodePrefix returns the leading letters of a barcode, up to the
+// maximum prefix length of 4
+func storageGatewayTapeBarcodePrefix(barcode string) string {
+	return regexp.MustCompile(`^[A-Z]{1,4}`).FindString(barcode)
+}
+
+func decodeStorageGatewayTapeID(id string) (string, string, error) {
+	// id = arn:aws:storagegateway:us-east-1:123456789012:gateway/sgw-12345678:TEST0AA2AF
+	idFormatErr := fmt.Errorf("expected ID in form of GatewayARN:TapeBarcode, received: %s", id)
+	gatewayARNAndBarcode, err := arn.Parse(id)
+	if err != nil {
+		return "", "", idFormatErr
+	}
+	// gatewayARNAndBarcode.Resource = gateway/sgw-12345678:TEST0AA2AF
+	resourceParts := strings.SplitN(gatewayARNAndBarcode.Resource, ":", 2)
+	if len(resourceParts) != 2 || !strings.HasPrefix(resourceParts[0], "gateway/") || resourceParts[1] == "" {
+		return "", "", idFormatErr
+	}
+	// resourceParts = ["gateway/sgw-12345678", "TEST0AA2AF"]
+	gatewayARN := arn.ARN{
+		AccountID: gatewayARNAndBarcode.AccountID,
+		Partition: gatewayARNAndBarcode.Partition,
+		Region:    gatewayARNAndBarcode.Region,
+		Service:   gatewayARNAndBarcode.Service,
+		Resource:  resourceParts[0],
+	}
+	tapeARN := arn.ARN{
+		AccountID: gatewayARNAndBarcode.AccountID,
+		Partition: gatewayARNAndBarcode.Partition,
+		Region:    gatewayARNAndBarcode.Region,
+		Service:   gatewayARNAndBarcode.Service,
+		Resource:  fmt.Sprintf("tape/%s", resourceParts[1]),
+	}
+	return gatewayARN.String(), tapeARN.String(), nil
+}
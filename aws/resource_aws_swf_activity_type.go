@@ -0,0 +1,230 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/swf"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsSwfActivityType() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSwfActivityTypeCreate,
+		Read:   resourceAwsSwfActivityTypeRead,
+		Delete: resourceAwsSwfActivityTypeDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceAwsSwfTypeCustomizeDiffRequireNewVersion(
+			"default_task_heartbeat_timeout",
+			"default_task_list",
+			"default_task_priority",
+			"default_task_schedule_to_close_timeout",
+			"default_task_schedule_to_start_timeout",
+			"default_task_start_to_close_timeout",
+			"description",
+		),
+
+		Schema: map[string]*schema.Schema{
+			"default_task_heartbeat_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSwfTimeout,
+			},
+			"default_task_list": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"default_task_priority": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"default_task_schedule_to_close_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSwfTimeout,
+			},
+			"default_task_schedule_to_start_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSwfTimeout,
+			},
+			"default_task_start_to_close_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSwfTimeout,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"domain": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsSwfActivityTypeCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).swfconn
+
+	domain := d.Get("domain").(string)
+	name := d.Get("name").(string)
+	version := d.Get("version").(string)
+
+	input := &swf.RegisterActivityTypeInput{
+		Domain:  aws.String(domain),
+		Name:    aws.String(name),
+		Version: aws.String(version),
+	}
+
+	if v, ok := d.GetOk("default_task_heartbeat_timeout"); ok {
+		input.DefaultTaskHeartbeatTimeout = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_task_list"); ok {
+		input.DefaultTaskList = &swf.TaskList{
+			Name: aws.String(v.(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("default_task_priority"); ok {
+		input.DefaultTaskPriority = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_task_schedule_to_close_timeout"); ok {
+		input.DefaultTaskScheduleToCloseTimeout = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_task_schedule_to_start_timeout"); ok {
+		input.DefaultTaskScheduleToStartTimeout = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_task_start_to_close_timeout"); ok {
+		input.DefaultTaskStartToCloseTimeout = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Registering SWF Activity Type: %s", input)
+	_, err := conn.RegisterActivityType(input)
+	if err != nil {
+		return fmt.Errorf("error registering SWF Activity Type: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s:%s", domain, name, version))
+
+	return resourceAwsSwfActivityTypeRead(d, meta)
+}
+
+func resourceAwsSwfActivityTypeRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).swfconn
+
+	domain, name, version, err := resourceAwsSwfTypeParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &swf.DescribeActivityTypeInput{
+		Domain: aws.String(domain),
+		ActivityType: &swf.ActivityType{
+			Name:    aws.String(name),
+			Version: aws.String(version),
+		},
+	}
+
+	resp, err := conn.DescribeActivityType(input)
+	if err != nil {
+		if isAWSErr(err, swf.ErrCodeUnknownResourceFault, "") {
+			log.Printf("[WARN] SWF Activity Type %q not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading SWF Activity Type (%s): %s", d.Id(), err)
+	}
+
+	if resp == nil || resp.Configuration == nil || resp.TypeInfo == nil {
+		log.Printf("[WARN] SWF Activity Type %q not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if aws.StringValue(resp.TypeInfo.Status) == swf.RegistrationStatusDeprecated {
+		log.Printf("[WARN] SWF Activity Type %q is deprecated, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	config := resp.Configuration
+
+	d.Set("default_task_heartbeat_timeout", config.DefaultTaskHeartbeatTimeout)
+	d.Set("default_task_list", "")
+	if config.DefaultTaskList != nil {
+		d.Set("default_task_list", config.DefaultTaskList.Name)
+	}
+	d.Set("default_task_priority", config.DefaultTaskPriority)
+	d.Set("default_task_schedule_to_close_timeout", config.DefaultTaskScheduleToCloseTimeout)
+	d.Set("default_task_schedule_to_start_timeout", config.DefaultTaskScheduleToStartTimeout)
+	d.Set("default_task_start_to_close_timeout", config.DefaultTaskStartToCloseTimeout)
+	d.Set("description", resp.TypeInfo.Description)
+	d.Set("domain", domain)
+	d.Set("name", name)
+	d.Set("version", version)
+
+	return nil
+}
+
+func resourceAwsSwfActivityTypeDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).swfconn
+
+	domain, name, version, err := resourceAwsSwfTypeParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &swf.DeprecateActivityTypeInput{
+		Domain: aws.String(domain),
+		ActivityType: &swf.ActivityType{
+			Name:    aws.String(name),
+			Version: aws.String(version),
+		},
+	}
+
+	log.Printf("[DEBUG] Deprecating SWF Activity Type: %s", input)
+	_, err = conn.DeprecateActivityType(input)
+	if err != nil {
+		if isAWSErr(err, swf.ErrCodeTypeDeprecatedFault, "") {
+			return nil
+		}
+		if isAWSErr(err, swf.ErrCodeUnknownResourceFault, "") {
+			return nil
+		}
+		return fmt.Errorf("error deprecating SWF Activity Type (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
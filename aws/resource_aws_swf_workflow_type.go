@@ -0,0 +1,271 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/swf"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsSwfWorkflowType() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSwfWorkflowTypeCreate,
+		Read:   resourceAwsSwfWorkflowTypeRead,
+		Delete: resourceAwsSwfWorkflowTypeDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceAwsSwfTypeCustomizeDiffRequireNewVersion(
+			"default_child_policy",
+			"default_execution_start_to_close_timeout",
+			"default_lambda_role",
+			"default_task_list",
+			"default_task_priority",
+			"default_task_start_to_close_timeout",
+			"description",
+		),
+
+		Schema: map[string]*schema.Schema{
+			"default_child_policy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					swf.ChildPolicyAbandon,
+					swf.ChildPolicyRequestCancel,
+					swf.ChildPolicyTerminate,
+				}, false),
+			},
+			"default_execution_start_to_close_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSwfTimeout,
+			},
+			"default_lambda_role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"default_task_list": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"default_task_priority": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"default_task_start_to_close_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSwfTimeout,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"domain": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsSwfWorkflowTypeCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).swfconn
+
+	domain := d.Get("domain").(string)
+	name := d.Get("name").(string)
+	version := d.Get("version").(string)
+
+	input := &swf.RegisterWorkflowTypeInput{
+		Domain:  aws.String(domain),
+		Name:    aws.String(name),
+		Version: aws.String(version),
+	}
+
+	if v, ok := d.GetOk("default_child_policy"); ok {
+		input.DefaultChildPolicy = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_execution_start_to_close_timeout"); ok {
+		input.DefaultExecutionStartToCloseTimeout = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_lambda_role"); ok {
+		input.DefaultLambdaRole = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_task_list"); ok {
+		input.DefaultTaskList = &swf.TaskList{
+			Name: aws.String(v.(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("default_task_priority"); ok {
+		input.DefaultTaskPriority = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_task_start_to_close_timeout"); ok {
+		input.DefaultTaskStartToCloseTimeout = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Registering SWF Workflow Type: %s", input)
+	_, err := conn.RegisterWorkflowType(input)
+	if err != nil {
+		return fmt.Errorf("error registering SWF Workflow Type: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s:%s", domain, name, version))
+
+	return resourceAwsSwfWorkflowTypeRead(d, meta)
+}
+
+func resourceAwsSwfWorkflowTypeRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).swfconn
+
+	domain, name, version, err := resourceAwsSwfTypeParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &swf.DescribeWorkflowTypeInput{
+		Domain: aws.String(domain),
+		WorkflowType: &swf.WorkflowType{
+			Name:    aws.String(name),
+			Version: aws.String(version),
+		},
+	}
+
+	resp, err := conn.DescribeWorkflowType(input)
+	if err != nil {
+		if isAWSErr(err, swf.ErrCodeUnknownResourceFault, "") {
+			log.Printf("[WARN] SWF Workflow Type %q not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading SWF Workflow Type (%s): %s", d.Id(), err)
+	}
+
+	if resp == nil || resp.Configuration == nil || resp.TypeInfo == nil {
+		log.Printf("[WARN] SWF Workflow Type %q not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if aws.StringValue(resp.TypeInfo.Status) == swf.RegistrationStatusDeprecated {
+		log.Printf("[WARN] SWF Workflow Type %q is deprecated, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	config := resp.Configuration
+
+	d.Set("default_child_policy", config.DefaultChildPolicy)
+	d.Set("default_execution_start_to_close_timeout", config.DefaultExecutionStartToCloseTimeout)
+	d.Set("default_lambda_role", config.DefaultLambdaRole)
+	d.Set("default_task_list", "")
+	if config.DefaultTaskList != nil {
+		d.Set("default_task_list", config.DefaultTaskList.Name)
+	}
+	d.Set("default_task_priority", config.DefaultTaskPriority)
+	d.Set("default_task_start_to_close_timeout", config.DefaultTaskStartToCloseTimeout)
+	d.Set("description", resp.TypeInfo.Description)
+	d.Set("domain", domain)
+	d.Set("name", name)
+	d.Set("version", version)
+
+	return nil
+}
+
+func resourceAwsSwfWorkflowTypeDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).swfconn
+
+	domain, name, version, err := resourceAwsSwfTypeParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &swf.DeprecateWorkflowTypeInput{
+		Domain: aws.String(domain),
+		WorkflowType: &swf.WorkflowType{
+			Name:    aws.String(name),
+			Version: aws.String(version),
+		},
+	}
+
+	log.Printf("[DEBUG] Deprecating SWF Workflow Type: %s", input)
+	_, err = conn.DeprecateWorkflowType(input)
+	if err != nil {
+		if isAWSErr(err, swf.ErrCodeTypeDeprecatedFault, "") {
+			return nil
+		}
+		if isAWSErr(err, swf.ErrCodeUnknownResourceFault, "") {
+			return nil
+		}
+		return fmt.Errorf("error deprecating SWF Workflow Type (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// resourceAwsSwfTypeCustomizeDiffRequireNewVersion returns an error when any of
+// the given workflow or activity type attributes changes without a change to
+// the domain, name or version. SWF types cannot be modified, and registering
+// the same type again after deprecating it fails.
+func resourceAwsSwfTypeCustomizeDiffRequireNewVersion(keys ...string) schema.CustomizeDiffFunc {
+	return func(diff *schema.ResourceDiff, meta interface{}) error {
+		if diff.Id() == "" {
+			return nil
+		}
+
+		if diff.HasChange("domain") || diff.HasChange("name") || diff.HasChange("version") {
+			return nil
+		}
+
+		for _, key := range keys {
+			if diff.HasChange(key) {
+				return fmt.Errorf("changing %s requires a new version, as SWF types cannot be modified or registered again", key)
+			}
+		}
+
+		return nil
+	}
+}
+
+// resourceAwsSwfTypeParseID parses workflow and activity type IDs in the form DOMAIN:NAME:VERSION
+func resourceAwsSwfTypeParseID(id string) (string, string, string, error) {
+	parts := strings.SplitN(id, ":", 3)
+
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("unexpected format of ID (%s), expected DOMAIN:NAME:VERSION", id)
+	}
+
+	return parts[0], parts[1], parts[2], nil
+}
@@ -0,0 +1,303 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/swf"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestResourceAwsSwfTypeParseID(t *testing.T) {
+	var testCases = []struct {
+		Input           string
+		ExpectedDomain  string
+		ExpectedName    string
+		ExpectedVersion string
+		ErrCount        int
+	}{
+		{
+			Input:           "example:workflow:1.0",
+			ExpectedDomain:  "example",
+			ExpectedName:    "workflow",
+			ExpectedVersion: "1.0",
+			ErrCount:        0,
+		},
+		{
+			Input:    "example:workflow",
+			ErrCount: 1,
+		},
+		{
+			Input:    "example::1.0",
+			ErrCount: 1,
+		},
+		{
+			Input:    "example",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		domain, name, version, err := resourceAwsSwfTypeParseID(tc.Input)
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("expected %q not to trigger an error, received: %s", tc.Input, err)
+		}
+		if tc.ErrCount > 0 && err == nil {
+			t.Fatalf("expected %q to trigger an error", tc.Input)
+		}
+		if domain != tc.ExpectedDomain || name != tc.ExpectedName || version != tc.ExpectedVersion {
+			t.Fatalf("expected %q to return %q, %q, %q, received: %q, %q, %q", tc.Input, tc.ExpectedDomain, tc.ExpectedName, tc.ExpectedVersion, domain, name, version)
+		}
+	}
+}
+
+func TestAccAWSSwfWorkflowType_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_swf_workflow_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckSwfDomainTestingEnabled(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSwfWorkflowTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSwfWorkflowTypeConfig_Basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAwsSwfWorkflowTypeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "default_child_policy", ""),
+					resource.TestCheckResourceAttr(resourceName, "default_task_list", ""),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "domain", "aws_swf_domain.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "version", "1.0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSwfWorkflowType_Defaults(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_swf_workflow_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckSwfDomainTestingEnabled(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSwfWorkflowTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSwfWorkflowTypeConfig_Defaults(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAwsSwfWorkflowTypeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "default_child_policy", "TERMINATE"),
+					resource.TestCheckResourceAttr(resourceName, "default_execution_start_to_close_timeout", "3600"),
+					resource.TestCheckResourceAttrPair(resourceName, "default_lambda_role", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "default_task_list", "tasks"),
+					resource.TestCheckResourceAttr(resourceName, "default_task_priority", "1"),
+					resource.TestCheckResourceAttr(resourceName, "default_task_start_to_close_timeout", "NONE"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test description"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSwfWorkflowType_Description(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_swf_workflow_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckSwfDomainTestingEnabled(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsSwfWorkflowTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSwfWorkflowTypeConfig_Description(rName, "1.0", "description1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAwsSwfWorkflowTypeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1.0"),
+				),
+			},
+			{
+				Config:      testAccAWSSwfWorkflowTypeConfig_Description(rName, "1.0", "description2"),
+				ExpectError: regexp.MustCompile(`changing description requires a new version`),
+			},
+			{
+				Config: testAccAWSSwfWorkflowTypeConfig_Description(rName, "2.0", "description2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAwsSwfWorkflowTypeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+					resource.TestCheckResourceAttr(resourceName, "version", "2.0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsSwfWorkflowTypeDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).swfconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_swf_workflow_type" {
+			continue
+		}
+
+		domain, name, version, err := resourceAwsSwfTypeParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := conn.DescribeWorkflowType(&swf.DescribeWorkflowTypeInput{
+			Domain: aws.String(domain),
+			WorkflowType: &swf.WorkflowType{
+				Name:    aws.String(name),
+				Version: aws.String(version),
+			},
+		})
+		if isAWSErr(err, swf.ErrCodeUnknownResourceFault, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if status := aws.StringValue(resp.TypeInfo.Status); status != swf.RegistrationStatusDeprecated {
+			return fmt.Errorf("SWF Workflow Type %s status is %s instead of %q", rs.Primary.ID, status, swf.RegistrationStatusDeprecated)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsSwfWorkflowTypeExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SWF Workflow Type ID is set")
+		}
+
+		domain, name, version, err := resourceAwsSwfTypeParseID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).swfconn
+
+		resp, err := conn.DescribeWorkflowType(&swf.DescribeWorkflowTypeInput{
+			Domain: aws.String(domain),
+			WorkflowType: &swf.WorkflowType{
+				Name:    aws.String(name),
+				Version: aws.String(version),
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		if status := aws.StringValue(resp.TypeInfo.Status); status != swf.RegistrationStatusRegistered {
+			return fmt.Errorf("SWF Workflow Type %s status is %s instead of %q", rs.Primary.ID, status, swf.RegistrationStatusRegistered)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSSwfWorkflowTypeConfig_Basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_swf_domain" "test" {
+  name                                        = %[1]q
+  workflow_execution_retention_period_in_days = 1
+}
+
+resource "aws_swf_workflow_type" "test" {
+  domain  = "${aws_swf_domain.test.name}"
+  name    = %[1]q
+  version = "1.0"
+}
+`, rName)
+}
+
+func testAccAWSSwfWorkflowTypeConfig_Defaults(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "swf.amazonaws.com"
+      }
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_swf_domain" "test" {
+  name                                        = %[1]q
+  workflow_execution_retention_period_in_days = 1
+}
+
+resource "aws_swf_workflow_type" "test" {
+  default_child_policy                     = "TERMINATE"
+  default_execution_start_to_close_timeout = "3600"
+  default_lambda_role                      = "${aws_iam_role.test.arn}"
+  default_task_list                        = "tasks"
+  default_task_priority                    = "1"
+  default_task_start_to_close_timeout      = "NONE"
+  description                              = "test description"
+  domain                                   = "${aws_swf_domain.test.name}"
+  name                                     = %[1]q
+  version                                  = "1.0"
+}
+`, rName)
+}
+
+func testAccAWSSwfWorkflowTypeConfig_Description(rName, version, description string) string {
+	return fmt.Sprintf(`
+resource "aws_swf_domain" "test" {
+  name                                        = %[1]q
+  workflow_execution_retention_period_in_days = 1
+}
+
+resource "aws_swf_workflow_type" "test" {
+  description = %[3]q
+  domain      = "${aws_swf_domain.test.name}"
+  name        = %[1]q
+  version     = %[2]q
+}
+`, rName, version, description)
+}
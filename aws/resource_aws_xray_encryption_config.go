@@ -0,0 +1,139 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/xray"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsXrayEncryptionConfig() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsXrayEncryptionConfigPut,
+		Read:   resourceAwsXrayEncryptionConfigRead,
+		Update: resourceAwsXrayEncryptionConfigPut,
+		Delete: resourceAwsXrayEncryptionConfigDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Update: schema.DefaultTimeout(15 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					xray.EncryptionTypeKms,
+					xray.EncryptionTypeNone,
+				}, false),
+			},
+			"key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceAwsXrayEncryptionConfigPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).xrayconn
+
+	params := &xray.PutEncryptionConfigInput{
+		Type: aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("key_id"); ok {
+		params.KeyId = aws.String(v.(string))
+	}
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+
+	if err := putXrayEncryptionConfig(conn, params, timeout); err != nil {
+		return fmt.Errorf("error putting XRay Encryption Config: %s", err)
+	}
+
+	d.SetId(meta.(*AWSClient).region)
+
+	return resourceAwsXrayEncryptionConfigRead(d, meta)
+}
+
+func resourceAwsXrayEncryptionConfigRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).xrayconn
+
+	out, err := conn.GetEncryptionConfig(&xray.GetEncryptionConfigInput{})
+	if err != nil {
+		return fmt.Errorf("error reading XRay Encryption Config: %s", err)
+	}
+
+	if out == nil || out.EncryptionConfig == nil {
+		return fmt.Errorf("error reading XRay Encryption Config: empty response")
+	}
+
+	d.Set("type", out.EncryptionConfig.Type)
+	d.Set("key_id", out.EncryptionConfig.KeyId)
+
+	return nil
+}
+
+func resourceAwsXrayEncryptionConfigDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).xrayconn
+
+	log.Printf("[INFO] Resetting XRay Encryption Config: %s", d.Id())
+
+	params := &xray.PutEncryptionConfigInput{
+		Type: aws.String(xray.EncryptionTypeNone),
+	}
+
+	if err := putXrayEncryptionConfig(conn, params, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error resetting XRay Encryption Config: %s", err)
+	}
+
+	return nil
+}
+
+func putXrayEncryptionConfig(conn *xray.XRay, params *xray.PutEncryptionConfigInput, timeout time.Duration) error {
+	log.Printf("[DEBUG] Putting XRay Encryption Config: %s", params)
+	if _, err := conn.PutEncryptionConfig(params); err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{xray.EncryptionStatusUpdating},
+		Target:  []string{xray.EncryptionStatusActive},
+		Refresh: xrayEncryptionConfigStatusRefreshFunc(conn),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func xrayEncryptionConfigStatusRefreshFunc(conn *xray.XRay) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := conn.GetEncryptionConfig(&xray.GetEncryptionConfigInput{})
+		if err != nil {
+			return nil, "", err
+		}
+
+		if out == nil || out.EncryptionConfig == nil {
+			return nil, "", nil
+		}
+
+		return out.EncryptionConfig, aws.StringValue(out.EncryptionConfig.Status), nil
+	}
+}
@@ -0,0 +1,63 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// Encryption configuration is a single per-region setting, so these tests must not run in parallel
+func TestAccAWSXrayEncryptionConfig_basic(t *testing.T) {
+	resourceName := "aws_xray_encryption_config.test"
+	keyResourceName := "aws_kms_key.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccPreCheckAWSXray(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSXrayEncryptionConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", "NONE"),
+					resource.TestCheckResourceAttr(resourceName, "key_id", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSXrayEncryptionConfig_KmsKey,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", "KMS"),
+					resource.TestCheckResourceAttrPair(resourceName, "key_id", keyResourceName, "arn"),
+				),
+			},
+			{
+				Config: testAccAWSXrayEncryptionConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", "NONE"),
+				),
+			},
+		},
+	})
+}
+
+const testAccAWSXrayEncryptionConfig_basic = `
+resource "aws_xray_encryption_config" "test" {
+  type = "NONE"
+}
+`
+
+const testAccAWSXrayEncryptionConfig_KmsKey = `
+resource "aws_kms_key" "test" {
+  description             = "Terraform acc test"
+  deletion_window_in_days = 7
+}
+
+resource "aws_xray_encryption_config" "test" {
+  type   = "KMS"
+  key_id = "${aws_kms_key.test.arn}"
+}
+`
@@ -0,0 +1,110 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/xray"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSXrayGroup_basic(t *testing.T) {
+	var group xray.Group
+	resourceName := "aws_xray_group.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSXray(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSXrayGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSXrayGroupConfig_basic(rName, "responsetime > 5"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckXrayGroupExists(resourceName, &group),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(fmt.Sprintf(`^arn:[^:]+:xray:[^:]+:\d{12}:group/%s/.+$`, rName))),
+					resource.TestCheckResourceAttr(resourceName, "group_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "filter_expression", "responsetime > 5"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSXrayGroupConfig_basic(rName, "responsetime > 10"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckXrayGroupExists(resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "group_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "filter_expression", "responsetime > 10"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckXrayGroupExists(n string, group *xray.Group) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No XRay Group ID is set")
+		}
+		conn := testAccProvider.Meta().(*AWSClient).xrayconn
+
+		out, err := conn.GetGroup(&xray.GetGroupInput{
+			GroupARN: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		*group = *out.Group
+
+		return nil
+	}
+}
+
+func testAccCheckAWSXrayGroupDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_xray_group" {
+			continue
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).xrayconn
+
+		_, err := conn.GetGroup(&xray.GetGroupInput{
+			GroupARN: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, xray.ErrCodeInvalidRequestException, "Group not found") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Expected XRay Group to be destroyed, %s found", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAWSXrayGroupConfig_basic(rName, filterExpression string) string {
+	return fmt.Sprintf(`
+resource "aws_xray_group" "test" {
+  group_name        = %[1]q
+  filter_expression = %[2]q
+}
+`, rName, filterExpression)
+}
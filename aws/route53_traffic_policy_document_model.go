@@ -0,0 +1,68 @@
+package aws
+
+type Route53TrafficPolicyDoc struct {
+	AWSPolicyFormatVersion string                                   `json:"AWSPolicyFormatVersion"`
+	RecordType             string                                   `json:"RecordType"`
+	StartEndpoint          string                                   `json:"StartEndpoint,omitempty"`
+	StartRule              string                                   `json:"StartRule,omitempty"`
+	Endpoints              map[string]*Route53TrafficPolicyEndpoint `json:"Endpoints,omitempty"`
+	Rules                  map[string]*Route53TrafficPolicyRule     `json:"Rules,omitempty"`
+}
+
+type Route53TrafficPolicyEndpoint struct {
+	Type   string `json:"Type,omitempty"`
+	Region string `json:"Region,omitempty"`
+	Value  string `json:"Value,omitempty"`
+}
+
+type Route53TrafficPolicyRule struct {
+	RuleType              string                                      `json:"RuleType"`
+	Primary               *Route53TrafficPolicyFailoverRule           `json:"Primary,omitempty"`
+	Secondary             *Route53TrafficPolicyFailoverRule           `json:"Secondary,omitempty"`
+	Locations             []*Route53TrafficPolicyGeolocationRule      `json:"Locations,omitempty"`
+	GeoProximityLocations []*Route53TrafficPolicyGeoproximityRule     `json:"GeoproximityLocations,omitempty"`
+	Regions               []*Route53TrafficPolicyLatencyRule          `json:"Regions,omitempty"`
+	Items                 []*Route53TrafficPolicyMultiValueAnswerRule `json:"Items,omitempty"`
+}
+
+type Route53TrafficPolicyFailoverRule struct {
+	EndpointReference    string `json:"EndpointReference,omitempty"`
+	RuleReference        string `json:"RuleReference,omitempty"`
+	EvaluateTargetHealth *bool  `json:"EvaluateTargetHealth,omitempty"`
+	HealthCheck          string `json:"HealthCheck,omitempty"`
+}
+
+type Route53TrafficPolicyGeolocationRule struct {
+	EndpointReference    string `json:"EndpointReference,omitempty"`
+	RuleReference        string `json:"RuleReference,omitempty"`
+	IsDefault            *bool  `json:"IsDefault,omitempty"`
+	Continent            string `json:"Continent,omitempty"`
+	Country              string `json:"Country,omitempty"`
+	Subdivision          string `json:"Subdivision,omitempty"`
+	EvaluateTargetHealth *bool  `json:"EvaluateTargetHealth,omitempty"`
+	HealthCheck          string `json:"HealthCheck,omitempty"`
+}
+
+type Route53TrafficPolicyGeoproximityRule struct {
+	EndpointReference    string `json:"EndpointReference,omitempty"`
+	RuleReference        string `json:"RuleReference,omitempty"`
+	Region               string `json:"Region,omitempty"`
+	Latitude             string `json:"Latitude,omitempty"`
+	Longitude            string `json:"Longitude,omitempty"`
+	Bias                 string `json:"Bias,omitempty"`
+	EvaluateTargetHealth *bool  `json:"EvaluateTargetHealth,omitempty"`
+	HealthCheck          string `json:"HealthCheck,omitempty"`
+}
+
+type Route53TrafficPolicyLatencyRule struct {
+	EndpointReference    string `json:"EndpointReference,omitempty"`
+	RuleReference        string `json:"RuleReference,omitempty"`
+	Region               string `json:"Region,omitempty"`
+	EvaluateTargetHealth *bool  `json:"EvaluateTargetHealth,omitempty"`
+	HealthCheck          string `json:"HealthCheck,omitempty"`
+}
+
+type Route53TrafficPolicyMultiValueAnswerRule struct {
+	EndpointReference string `json:"EndpointReference,omitempty"`
+	HealthCheck       string `json:"HealthCheck,omitempty"`
+}
@@ -555,6 +555,24 @@ func validateCloudWatchDashboardName(v interface{}, k string) (ws []string, erro
 	return
 }
 
+func validateCloudWatchEventBusName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) > 256 {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot be longer than 256 characters: %q", k, value))
+	}
+
+	// https://docs.aws.amazon.com/AmazonCloudWatchEvents/latest/APIReference/API_CreateEventBus.html
+	pattern := `^[/\.\-_A-Za-z0-9]+$`
+	if !regexp.MustCompile(pattern).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q doesn't comply with restrictions (%q): %q",
+			k, pattern, value))
+	}
+
+	return
+}
+
 func validateCloudWatchEventRuleName(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 	if len(value) > 64 {
@@ -2373,3 +2391,17 @@ func validateRoute53ResolverName(v interface{}, k string) (ws []string, errors [
 
 	return
 }
+
+func validateSwfTimeout(v interface{}, k string) (ws []string, errors []error) {
+	// Timeouts are specified in seconds as a string, or NONE for no timeout
+	value := v.(string)
+	if value == "NONE" {
+		return
+	}
+	if !regexp.MustCompile(`^[0-9]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must be a number of seconds or NONE", k))
+	}
+
+	return
+}
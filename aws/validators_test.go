@@ -141,6 +141,35 @@ func TestValidateCloudWatchDashboardName(t *testing.T) {
 	}
 }
 
+func TestValidateCloudWatchEventBusName(t *testing.T) {
+	validNames := []string{
+		"default",
+		"HelloWorl_d",
+		"hello-world",
+		"hello.World0125",
+		"aws.partner/example.com/123456789012/test",
+	}
+	for _, v := range validNames {
+		_, errors := validateCloudWatchEventBusName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid CW event bus name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"special@character",
+		// Length > 256
+		strings.Repeat("W", 257),
+	}
+	for _, v := range invalidNames {
+		_, errors := validateCloudWatchEventBusName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid CW event bus name", v)
+		}
+	}
+}
+
 func TestValidateCloudWatchEventRuleName(t *testing.T) {
 	validNames := []string{
 		"HelloWorl_d",
@@ -2994,3 +3023,41 @@ func TestValidateRoute53ResolverName(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateSwfTimeout(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "NONE",
+			ErrCount: 0,
+		},
+		{
+			Value:    "0",
+			ErrCount: 0,
+		},
+		{
+			Value:    "3600",
+			ErrCount: 0,
+		},
+		{
+			Value:    "none",
+			ErrCount: 1,
+		},
+		{
+			Value:    "-1",
+			ErrCount: 1,
+		},
+		{
+			Value:    "1h",
+			ErrCount: 1,
+		},
+	}
+	for _, tc := range cases {
+		_, errors := validateSwfTimeout(tc.Value, "default_task_start_to_close_timeout")
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d validation errors for %q, got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}